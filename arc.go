@@ -0,0 +1,251 @@
+package collections
+
+// ARCCache is a fixed-size cache using the Adaptive Replacement Cache policy
+// of Megiddo and Modha. It keeps two lists of entries: T1 for keys seen once
+// recently and T2 for keys seen at least twice. Two ghost lists, B1 and B2,
+// remember the keys recently evicted from T1 and T2 without their values.
+// A hit on a ghost key shows which list was too small, and the cache moves
+// its target size for T1 towards it. This lets ARC adapt between recency and
+// frequency, and a single scan over many keys cannot flush the frequently
+// used ones. Every operation takes O(1) time.
+//
+// ARCCache is not safe for concurrent use.
+type ARCCache[K comparable, V any] struct {
+	capacity int
+	target   int // the size ARC aims for T1, between 0 and capacity
+	t1, t2   entryList[K, V]
+	b1, b2   entryList[K, V]
+	items    map[K]*cacheEntry[K, V] // entries in T1 and T2
+	ghosts   map[K]*cacheEntry[K, V] // entries in B1 and B2, without values
+	onEvict  func(K, V)
+	stats    CacheStats
+}
+
+// NewARCCache creates and returns a new empty cache holding up to capacity
+// entries. It also remembers up to capacity evicted keys. Capacities below 1
+// are raised to 1.
+func NewARCCache[K comparable, V any](capacity int) *ARCCache[K, V] {
+	c := &ARCCache[K, V]{capacity: max(capacity, 1)}
+	c.Clear()
+	return c
+}
+
+// OnEvict sets a function called with every entry that is evicted to make
+// room. It is not called by Remove, Clear or when Put replaces a value.
+// A nil fn removes the callback.
+func (c *ARCCache[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// evict removes e from the cache. If ghost is not nil, the key is
+// remembered at the front of that ghost list.
+func (c *ARCCache[K, V]) evict(e *cacheEntry[K, V], ghost *entryList[K, V]) {
+	e.owner.remove(e)
+	delete(c.items, e.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+
+	if ghost != nil {
+		var zero V
+		e.value = zero
+		c.ghosts[e.key] = e
+		ghost.pushFront(e)
+	}
+}
+
+// forget drops the last key of a ghost list.
+func (c *ARCCache[K, V]) forget(ghost *entryList[K, V]) {
+	if e := ghost.back(); e != nil {
+		ghost.remove(e)
+		delete(c.ghosts, e.key)
+	}
+}
+
+// replace makes room for one entry when the cache is full, moving the least
+// recently used entry of T1 or T2 to its ghost list. inB2 is true when the
+// new key was found in B2.
+func (c *ARCCache[K, V]) replace(inB2 bool) {
+	if c.t1.len+c.t2.len < c.capacity {
+		return
+	}
+	if c.t1.len > 0 && (c.t1.len > c.target || (inB2 && c.t1.len == c.target) || c.t2.len == 0) {
+		c.evict(c.t1.back(), &c.b1)
+	} else {
+		c.evict(c.t2.back(), &c.b2)
+	}
+}
+
+// Get returns the value for a key. A key found in T1 moves to T2, as it has
+// now been used twice. Returns false if the key is not in the cache.
+func (c *ARCCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.promote(e)
+	return e.value, true
+}
+
+// promote moves e to the front of T2.
+func (c *ARCCache[K, V]) promote(e *cacheEntry[K, V]) {
+	if e.owner == &c.t2 {
+		c.t2.moveToFront(e)
+		return
+	}
+	e.owner.remove(e)
+	c.t2.pushFront(e)
+}
+
+// Peek returns the value for a key without counting a use, a hit or a miss.
+// Returns false if the key is not in the cache.
+func (c *ARCCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains checks if the key is in the cache without counting a use.
+// Keys that are only remembered in a ghost list are not in the cache.
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put adds or replaces the value for a key. Replacing a value counts as a
+// use. If the cache is full, an entry from T1 or T2 is evicted depending on
+// the current target size. Returns true if a new key was added.
+func (c *ARCCache[K, V]) Put(key K, value V) bool {
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.promote(e)
+		return false
+	}
+
+	if e, ok := c.ghosts[key]; ok {
+		// The key was evicted too early: grow the list it was evicted from
+		inB2 := e.owner == &c.b2
+		if inB2 {
+			c.target = max(c.target-max(c.b1.len/c.b2.len, 1), 0)
+		} else {
+			c.target = min(c.target+max(c.b2.len/c.b1.len, 1), c.capacity)
+		}
+		c.replace(inB2)
+
+		e.owner.remove(e)
+		delete(c.ghosts, key)
+		e.value = value
+		c.items[key] = e
+		c.t2.pushFront(e)
+		return true
+	}
+
+	if c.t1.len+c.b1.len >= c.capacity {
+		if c.t1.len < c.capacity {
+			c.forget(&c.b1)
+			c.replace(false)
+		} else {
+			c.evict(c.t1.back(), nil)
+		}
+	} else if total := c.t1.len + c.t2.len + c.b1.len + c.b2.len; total >= c.capacity {
+		if total >= 2*c.capacity {
+			c.forget(&c.b2)
+		}
+		c.replace(false)
+	}
+
+	e := &cacheEntry[K, V]{key: key, value: value}
+	c.items[key] = e
+	c.t1.pushFront(e)
+	return true
+}
+
+// Remove removes a key from the cache, and forgets it if it is in a ghost
+// list. Returns false if the key is not in the cache.
+func (c *ARCCache[K, V]) Remove(key K) bool {
+	if e, ok := c.ghosts[key]; ok {
+		e.owner.remove(e)
+		delete(c.ghosts, key)
+	}
+
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	e.owner.remove(e)
+	delete(c.items, key)
+	return true
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (c *ARCCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Len returns the number of entries in the cache, not counting ghost keys.
+func (c *ARCCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// IsEmpty returns true if the cache has no entries.
+func (c *ARCCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// Clear removes all entries and ghost keys from the cache and resets its
+// target size. The stats are kept.
+func (c *ARCCache[K, V]) Clear() {
+	c.target = 0
+	c.t1.init()
+	c.t2.init()
+	c.b1.init()
+	c.b2.init()
+	c.items = make(map[K]*cacheEntry[K, V])
+	c.ghosts = make(map[K]*cacheEntry[K, V])
+}
+
+// ForEach applies a function to each entry: first those used at least twice
+// (T2), then those used once (T1), each from the most to the least recently
+// used.
+func (c *ARCCache[K, V]) ForEach(fn func(K, V)) {
+	for _, l := range []*entryList[K, V]{&c.t2, &c.t1} {
+		for e := l.root.next; e != &l.root; e = e.next {
+			fn(e.key, e.value)
+		}
+	}
+}
+
+// Keys returns the keys in the order used by ForEach.
+func (c *ARCCache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	c.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Stats returns the hit, miss and eviction counters.
+func (c *ARCCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero.
+func (c *ARCCache[K, V]) ResetStats() {
+	c.stats = CacheStats{}
+}
+
+// String returns a string representation of the entries in the order used
+// by ForEach.
+func (c *ARCCache[K, V]) String() string {
+	if len(c.items) == 0 {
+		return "ARCCache{empty}"
+	}
+	return "ARCCache{" + formatEntries(c.ForEach) + "}"
+}
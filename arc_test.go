@@ -0,0 +1,150 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkARC verifies the size limits that ARC keeps on its four lists.
+func checkARC[K comparable, V any](t *testing.T, c *ARCCache[K, V]) {
+	t.Helper()
+	t1, t2, b1, b2 := c.t1.len, c.t2.len, c.b1.len, c.b2.len
+	switch {
+	case t1+t2 > c.capacity:
+		t.Fatalf("T1 and T2 hold %d entries, capacity is %d", t1+t2, c.capacity)
+	case t1+b1 > c.capacity:
+		t.Fatalf("T1 and B1 hold %d keys, capacity is %d", t1+b1, c.capacity)
+	case t1+t2+b1+b2 > 2*c.capacity:
+		t.Fatalf("The lists hold %d keys, limit is %d", t1+t2+b1+b2, 2*c.capacity)
+	case c.target < 0 || c.target > c.capacity:
+		t.Fatalf("Target %d is out of range", c.target)
+	case len(c.items) != t1+t2 || len(c.ghosts) != b1+b2:
+		t.Fatal("Maps do not match the lists")
+	}
+}
+
+func TestNewARCCache(t *testing.T) {
+	c := NewARCCache[string, int](4)
+	if c == nil {
+		t.Fatal("NewARCCache() returned nil")
+	}
+	if !c.IsEmpty() || c.Capacity() != 4 {
+		t.Error("New cache should be empty with capacity 4")
+	}
+	if str := c.String(); str != "ARCCache{empty}" {
+		t.Errorf("Expected 'ARCCache{empty}', got '%s'", str)
+	}
+	if NewARCCache[int, int](0).Capacity() != 1 {
+		t.Error("Capacity below 1 should be raised to 1")
+	}
+}
+
+func TestARCCacheLists(t *testing.T) {
+	c := NewARCCache[int, string](4)
+	var evicted []int
+	c.OnEvict(func(key int, _ string) { evicted = append(evicted, key) })
+
+	for i := 1; i <= 4; i++ {
+		c.Put(i, "v")
+	}
+	c.Get(1)
+	c.Get(2)
+	if c.t1.len != 2 || c.t2.len != 2 {
+		t.Errorf("Expected 2 keys in T1 and 2 in T2, got %d and %d", c.t1.len, c.t2.len)
+	}
+	if !slices.Equal(c.Keys(), []int{2, 1, 4, 3}) {
+		t.Errorf("Expected [2 1 4 3], got %v", c.Keys())
+	}
+
+	// With a target of 0, new keys push the oldest key of T1 into B1
+	c.Put(5, "v")
+	if !slices.Equal(evicted, []int{3}) || c.b1.len != 1 {
+		t.Errorf("Expected 3 evicted into B1, got %v", evicted)
+	}
+	checkARC(t, c)
+
+	// A ghost hit in B1 raises the target and brings the key back into T2
+	if _, ok := c.Get(3); ok {
+		t.Error("Ghost keys are not in the cache")
+	}
+	if !c.Put(3, "again") {
+		t.Error("Putting a ghost key should add it")
+	}
+	if c.target != 1 {
+		t.Errorf("Expected target 1, got %d", c.target)
+	}
+	if v, _ := c.Peek(3); v != "again" || c.Keys()[0] != 3 {
+		t.Errorf("Expected 3 at the front of T2, got %v", c.Keys())
+	}
+	checkARC(t, c)
+
+	// Removing a ghost key forgets it
+	ghost := c.b1.back()
+	if ghost == nil {
+		ghost = c.b2.back()
+	}
+	if c.Remove(ghost.key) {
+		t.Error("Remove of a ghost key should return false")
+	}
+	if _, ok := c.ghosts[ghost.key]; ok {
+		t.Error("Remove should forget the ghost key")
+	}
+	checkARC(t, c)
+
+	c.Clear()
+	if !c.IsEmpty() || len(c.ghosts) != 0 || c.target != 0 {
+		t.Error("Clear should reset the lists and the target")
+	}
+}
+
+func TestARCCacheScanResistance(t *testing.T) {
+	c := NewARCCache[int, int](10)
+	lru := NewLRUCache[int, int](10)
+
+	// A small set of keys is used often
+	for range 3 {
+		for key := range 5 {
+			for _, cache := range []Cache[int, int]{c, lru} {
+				if _, ok := cache.Get(key); !ok {
+					cache.Put(key, key)
+				}
+			}
+		}
+	}
+
+	// A long scan touches each of many keys once
+	for key := 100; key < 200; key++ {
+		c.Put(key, key)
+		lru.Put(key, key)
+		checkARC(t, c)
+	}
+
+	for key := range 5 {
+		if !c.Contains(key) {
+			t.Errorf("ARC should keep the frequently used key %d", key)
+		}
+		if lru.Contains(key) {
+			t.Errorf("LRU should have flushed key %d", key)
+		}
+	}
+}
+
+func TestARCCacheAdapts(t *testing.T) {
+	c := NewARCCache[int, int](8)
+	for i := range 2000 {
+		key := (i * 31) % 23
+		if i%3 == 0 {
+			key = i % 5
+		}
+		if _, ok := c.Get(key); !ok {
+			c.Put(key, i)
+		}
+		if i%17 == 0 {
+			c.Remove(key)
+		}
+		checkARC(t, c)
+	}
+	if c.Stats().Hits == 0 || c.Stats().Evictions == 0 {
+		t.Errorf("Expected hits and evictions, got %+v", c.Stats())
+	}
+}
@@ -0,0 +1,350 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// avlNode represents a node in an AVL tree.
+type avlNode[T any] struct {
+	value  T
+	left   *avlNode[T]
+	right  *avlNode[T]
+	height int
+	size   int // number of nodes in the subtree, used by OrderStatisticTree
+}
+
+// AVLTree represents a self-balancing binary search tree.
+// The heights of the two subtrees of every node differ by at most one,
+// so insertions, deletions and lookups take O(log n) time.
+type AVLTree[T any] struct {
+	root    *avlNode[T]
+	size    int
+	compare func(a, b T) int
+}
+
+// NewAVLTree creates and returns a new empty AVL tree ordered by the natural order of T.
+func NewAVLTree[T cmp.Ordered]() *AVLTree[T] {
+	return NewAVLTreeFunc(cmp.Compare[T])
+}
+
+// NewAVLTreeFunc creates and returns a new empty AVL tree ordered by compare.
+// compare must return a negative number when a < b, zero when a == b and a
+// positive number when a > b.
+func NewAVLTreeFunc[T any](compare func(a, b T) int) *AVLTree[T] {
+	return &AVLTree[T]{compare: compare}
+}
+
+func avlHeight[T any](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func avlSize[T any](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// avlUpdate recomputes the height and subtree size of node from its children.
+func avlUpdate[T any](node *avlNode[T]) {
+	node.height = 1 + max(avlHeight(node.left), avlHeight(node.right))
+	node.size = 1 + avlSize(node.left) + avlSize(node.right)
+}
+
+func avlBalanceFactor[T any](node *avlNode[T]) int {
+	return avlHeight(node.left) - avlHeight(node.right)
+}
+
+func avlRotateRight[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	avlUpdate(node)
+	avlUpdate(pivot)
+	return pivot
+}
+
+func avlRotateLeft[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	avlUpdate(node)
+	avlUpdate(pivot)
+	return pivot
+}
+
+// avlRebalance restores the AVL property at node and returns the new subtree root.
+func avlRebalance[T any](node *avlNode[T]) *avlNode[T] {
+	avlUpdate(node)
+	balance := avlBalanceFactor(node)
+
+	if balance > 1 {
+		if avlBalanceFactor(node.left) < 0 {
+			node.left = avlRotateLeft(node.left)
+		}
+		return avlRotateRight(node)
+	}
+	if balance < -1 {
+		if avlBalanceFactor(node.right) > 0 {
+			node.right = avlRotateRight(node.right)
+		}
+		return avlRotateLeft(node)
+	}
+	return node
+}
+
+// Insert adds a value to the tree and rebalances it.
+// Returns false if the value is already in the tree.
+func (t *AVLTree[T]) Insert(value T) bool {
+	inserted := false
+	t.root = t.insertHelper(t.root, value, &inserted)
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+func (t *AVLTree[T]) insertHelper(node *avlNode[T], value T, inserted *bool) *avlNode[T] {
+	if node == nil {
+		*inserted = true
+		return &avlNode[T]{value: value, height: 1, size: 1}
+	}
+
+	c := t.compare(value, node.value)
+	switch {
+	case c < 0:
+		node.left = t.insertHelper(node.left, value, inserted)
+	case c > 0:
+		node.right = t.insertHelper(node.right, value, inserted)
+	default:
+		return node
+	}
+	return avlRebalance(node)
+}
+
+// Delete removes a value from the tree and rebalances it.
+// Returns false if the value is not in the tree.
+func (t *AVLTree[T]) Delete(value T) bool {
+	deleted := false
+	t.root = t.deleteHelper(t.root, value, &deleted)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+func (t *AVLTree[T]) deleteHelper(node *avlNode[T], value T, deleted *bool) *avlNode[T] {
+	if node == nil {
+		return nil
+	}
+
+	c := t.compare(value, node.value)
+	switch {
+	case c < 0:
+		node.left = t.deleteHelper(node.left, value, deleted)
+	case c > 0:
+		node.right = t.deleteHelper(node.right, value, deleted)
+	default:
+		*deleted = true
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		// Two children: replace with the in-order successor
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.value = successor.value
+		node.right = t.deleteHelper(node.right, successor.value, new(bool))
+	}
+	return avlRebalance(node)
+}
+
+// Contains checks if a value exists in the tree.
+func (t *AVLTree[T]) Contains(value T) bool {
+	current := t.root
+	for current != nil {
+		c := t.compare(value, current.value)
+		switch {
+		case c == 0:
+			return true
+		case c < 0:
+			current = current.left
+		default:
+			current = current.right
+		}
+	}
+	return false
+}
+
+// Min returns the smallest value in the tree.
+// Returns false if the tree is empty.
+func (t *AVLTree[T]) Min() (T, bool) {
+	var zero T
+
+	if t.root == nil {
+		return zero, false
+	}
+
+	current := t.root
+	for current.left != nil {
+		current = current.left
+	}
+	return current.value, true
+}
+
+// Max returns the largest value in the tree.
+// Returns false if the tree is empty.
+func (t *AVLTree[T]) Max() (T, bool) {
+	var zero T
+
+	if t.root == nil {
+		return zero, false
+	}
+
+	current := t.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.value, true
+}
+
+// IsEmpty returns true if the tree has no nodes.
+func (t *AVLTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Size returns the number of nodes in the tree.
+func (t *AVLTree[T]) Size() int {
+	return t.size
+}
+
+// Len returns the number of nodes in the tree. It is the same as Size.
+func (t *AVLTree[T]) Len() int {
+	return t.size
+}
+
+// Clear removes all nodes from the tree.
+func (t *AVLTree[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// MaxDepth returns the maximum depth of the tree.
+// Heights are stored in the nodes, so this takes O(1) time.
+func (t *AVLTree[T]) MaxDepth() int {
+	return avlHeight(t.root)
+}
+
+// ToSlice returns the values of the tree in ascending order.
+func (t *AVLTree[T]) ToSlice() []T {
+	return t.InOrder()
+}
+
+// InOrder performs an in-order traversal (Left, Root, Right) and returns the
+// values in ascending order.
+func (t *AVLTree[T]) InOrder() []T {
+	result := []T{}
+	avlInOrderHelper(t.root, &result)
+	return result
+}
+
+func avlInOrderHelper[T any](node *avlNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	avlInOrderHelper(node.left, result)
+	*result = append(*result, node.value)
+	avlInOrderHelper(node.right, result)
+}
+
+// PreOrder performs a pre-order traversal (Root, Left, Right) and returns the values.
+func (t *AVLTree[T]) PreOrder() []T {
+	result := []T{}
+	avlPreOrderHelper(t.root, &result)
+	return result
+}
+
+func avlPreOrderHelper[T any](node *avlNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	*result = append(*result, node.value)
+	avlPreOrderHelper(node.left, result)
+	avlPreOrderHelper(node.right, result)
+}
+
+// PostOrder performs a post-order traversal (Left, Right, Root) and returns the values.
+func (t *AVLTree[T]) PostOrder() []T {
+	result := []T{}
+	avlPostOrderHelper(t.root, &result)
+	return result
+}
+
+func avlPostOrderHelper[T any](node *avlNode[T], result *[]T) {
+	if node == nil {
+		return
+	}
+	avlPostOrderHelper(node.left, result)
+	avlPostOrderHelper(node.right, result)
+	*result = append(*result, node.value)
+}
+
+// LevelOrder performs a level-order (BFS) traversal and returns the values.
+func (t *AVLTree[T]) LevelOrder() []T {
+	result := []T{}
+	if t.root == nil {
+		return result
+	}
+
+	queue := NewQueue[*avlNode[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		result = append(result, current.value)
+		if current.left != nil {
+			queue.Enqueue(current.left)
+		}
+		if current.right != nil {
+			queue.Enqueue(current.right)
+		}
+	}
+	return result
+}
+
+// String returns a string representation of the tree using BFS (level-order traversal).
+func (t *AVLTree[T]) String() string {
+	if t.root == nil {
+		return "AVLTree{empty}\n"
+	}
+	output := ""
+	queue := NewQueue[*avlNode[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		currentLevel := []T{}
+		levelSize := queue.Len()
+
+		for range levelSize {
+			currentNode, _ := queue.Next()
+			currentLevel = append(currentLevel, currentNode.value)
+			if currentNode.left != nil {
+				queue.Enqueue(currentNode.left)
+			}
+			if currentNode.right != nil {
+				queue.Enqueue(currentNode.right)
+			}
+		}
+		output += fmt.Sprintf("%v\n", currentLevel)
+	}
+	return output
+}
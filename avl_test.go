@@ -0,0 +1,216 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkAVL verifies the BST order, stored heights and balance of every node.
+// Returns the height of the subtree.
+func checkAVL[T any](t *testing.T, node *avlNode[T], compare func(a, b T) int) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+	if node.left != nil && compare(node.left.value, node.value) >= 0 {
+		t.Errorf("Left child %v should be less than %v", node.left.value, node.value)
+	}
+	if node.right != nil && compare(node.right.value, node.value) <= 0 {
+		t.Errorf("Right child %v should be greater than %v", node.right.value, node.value)
+	}
+
+	left := checkAVL(t, node.left, compare)
+	right := checkAVL(t, node.right, compare)
+	if left-right > 1 || right-left > 1 {
+		t.Errorf("Node %v is unbalanced: left height %d, right height %d", node.value, left, right)
+	}
+
+	height := 1 + max(left, right)
+	if node.height != height {
+		t.Errorf("Node %v stores height %d, actual height is %d", node.value, node.height, height)
+	}
+	return height
+}
+
+func TestNewAVLTree(t *testing.T) {
+	tree := NewAVLTree[int]()
+	if tree == nil {
+		t.Fatal("NewAVLTree() returned nil")
+	}
+	if !tree.IsEmpty() {
+		t.Error("New tree should be empty")
+	}
+	if tree.MaxDepth() != 0 {
+		t.Errorf("Expected max depth 0, got %d", tree.MaxDepth())
+	}
+}
+
+func TestAVLInsertSorted(t *testing.T) {
+	tree := NewAVLTree[int]()
+
+	// Sorted input degrades a plain BST into a list
+	for i := range 1000 {
+		if !tree.Insert(i) {
+			t.Fatalf("Insert(%d) should succeed", i)
+		}
+	}
+
+	if tree.Insert(500) {
+		t.Error("Insert of a duplicate should return false")
+	}
+	if tree.Size() != 1000 {
+		t.Errorf("Expected size 1000, got %d", tree.Size())
+	}
+	// An AVL tree with 1000 nodes is at most 1.44 * log2(1000) ~ 14 levels deep
+	if tree.MaxDepth() > 14 {
+		t.Errorf("Expected max depth at most 14, got %d", tree.MaxDepth())
+	}
+	checkAVL(t, tree.root, tree.compare)
+}
+
+func TestAVLDelete(t *testing.T) {
+	tree := NewAVLTree[int]()
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i)
+	}
+
+	if !tree.Delete(4) {
+		t.Error("Delete(4) should succeed")
+	}
+	if tree.Delete(4) {
+		t.Error("Delete of a missing value should return false")
+	}
+	if tree.Contains(4) {
+		t.Error("Tree should not contain 4 after delete")
+	}
+	if tree.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", tree.Size())
+	}
+	checkAVL(t, tree.root, tree.compare)
+}
+
+func TestAVLMinMax(t *testing.T) {
+	tree := NewAVLTree[string]()
+	if _, ok := tree.Min(); ok {
+		t.Error("Min on empty tree should fail")
+	}
+	if _, ok := tree.Max(); ok {
+		t.Error("Max on empty tree should fail")
+	}
+
+	for _, val := range []string{"m", "c", "x", "a"} {
+		tree.Insert(val)
+	}
+	if val, _ := tree.Min(); val != "a" {
+		t.Errorf("Expected min 'a', got '%s'", val)
+	}
+	if val, _ := tree.Max(); val != "x" {
+		t.Errorf("Expected max 'x', got '%s'", val)
+	}
+}
+
+func TestAVLTraversals(t *testing.T) {
+	tree := NewAVLTree[int]()
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i)
+	}
+
+	// Inserting 1..7 in order yields a perfect tree
+	//        4
+	//      /   \
+	//     2     6
+	//    / \   / \
+	//   1   3 5   7
+	tests := []struct {
+		name     string
+		got      []int
+		expected []int
+	}{
+		{"InOrder", tree.InOrder(), []int{1, 2, 3, 4, 5, 6, 7}},
+		{"PreOrder", tree.PreOrder(), []int{4, 2, 1, 3, 6, 5, 7}},
+		{"PostOrder", tree.PostOrder(), []int{1, 3, 2, 5, 7, 6, 4}},
+		{"LevelOrder", tree.LevelOrder(), []int{4, 2, 6, 1, 3, 5, 7}},
+	}
+
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+
+	if tree.MaxDepth() != 3 {
+		t.Errorf("Expected max depth 3, got %d", tree.MaxDepth())
+	}
+}
+
+func TestAVLRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+
+	for round := range 20 {
+		tree := NewAVLTree[int]()
+		reference := map[int]bool{}
+
+		for range 500 {
+			val := rng.IntN(200)
+			if rng.IntN(3) == 0 {
+				if tree.Delete(val) != reference[val] {
+					t.Fatalf("Round %d: Delete(%d) disagreed with reference", round, val)
+				}
+				delete(reference, val)
+			} else {
+				if tree.Insert(val) == reference[val] {
+					t.Fatalf("Round %d: Insert(%d) disagreed with reference", round, val)
+				}
+				reference[val] = true
+			}
+		}
+
+		checkAVL(t, tree.root, tree.compare)
+
+		expected := make([]int, 0, len(reference))
+		for val := range reference {
+			expected = append(expected, val)
+		}
+		slices.Sort(expected)
+
+		if !slices.Equal(tree.InOrder(), expected) {
+			t.Fatalf("Round %d: expected in-order %v, got %v", round, expected, tree.InOrder())
+		}
+		if tree.Size() != len(expected) {
+			t.Fatalf("Round %d: expected size %d, got %d", round, len(expected), tree.Size())
+		}
+	}
+}
+
+func TestAVLWithComparator(t *testing.T) {
+	// Descending order
+	tree := NewAVLTreeFunc(func(a, b int) int { return b - a })
+	for _, val := range []int{1, 3, 2} {
+		tree.Insert(val)
+	}
+
+	if !slices.Equal(tree.InOrder(), []int{3, 2, 1}) {
+		t.Errorf("Expected in-order [3 2 1], got %v", tree.InOrder())
+	}
+}
+
+func TestAVLClearAndString(t *testing.T) {
+	tree := NewAVLTree[int]()
+	if str := tree.String(); str != "AVLTree{empty}\n" {
+		t.Errorf("Expected empty tree string, got %s", str)
+	}
+
+	tree.Insert(1)
+	tree.Insert(2)
+	tree.Insert(3)
+	if str := tree.String(); str != "[2]\n[1 3]\n" {
+		t.Errorf("Expected '[2]\\n[1 3]\\n', got %q", str)
+	}
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.Size() != 0 {
+		t.Error("Tree should be empty after Clear()")
+	}
+}
@@ -0,0 +1,152 @@
+package collections
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ErrInvalidEncoding is returned when encoded data is malformed or does not
+// describe the container it is decoded into.
+var ErrInvalidEncoding = errors.New("collections: invalid encoding")
+
+// The binary format starts with a five byte header: the magic bytes "CL", a
+// format version, the kind of container and a flags byte. Counts and lengths
+// that follow are unsigned varints, and every value is stored as its length
+// followed by the bytes produced by the element codec.
+const (
+	binaryMagic   = "CL"
+	binaryVersion = 1
+
+	binaryKindQueue    = 1
+	binaryKindList     = 2
+	binaryKindTree     = 3
+	binaryKindUnrolled = 4
+	binaryKindSorted   = 5 // AVLTree and TreeSet
+	binaryKindMap      = 6 // TreeMap and SkipList
+
+	binaryFlagCircular = 1 << 0
+)
+
+func appendBinaryHeader(buf []byte, kind, flags byte) []byte {
+	return append(buf, binaryMagic[0], binaryMagic[1], binaryVersion, kind, flags)
+}
+
+// encodeBinary collects the output of a WriteStream call in memory.
+func encodeBinary(write func(io.Writer) (int64, error)) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBinary encodes the queue with the given element codec, or with
+// DefaultCodec if codec is nil. The capacity of a bounded queue is kept.
+func (q *Queue[T]) EncodeBinary(codec Codec[T]) ([]byte, error) {
+	return encodeBinary(func(w io.Writer) (int64, error) {
+		return q.WriteStream(w, BinaryStream(codec))
+	})
+}
+
+// DecodeBinary replaces the contents of the queue with data produced by
+// EncodeBinary, using the same codec. A nil codec means DefaultCodec.
+// The queue is left unchanged if data is malformed.
+func (q *Queue[T]) DecodeBinary(data []byte, codec Codec[T]) error {
+	_, err := q.ReadStream(bytes.NewReader(data), BinaryStream(codec))
+	return err
+}
+
+// EncodeBinary encodes the list with the given element codec, or with
+// DefaultCodec if codec is nil. The circular flag is kept.
+func (l *LinkedList[T]) EncodeBinary(codec Codec[T]) ([]byte, error) {
+	return encodeBinary(func(w io.Writer) (int64, error) {
+		return l.WriteStream(w, BinaryStream(codec))
+	})
+}
+
+// DecodeBinary replaces the contents of the list with data produced by
+// EncodeBinary, using the same codec. A nil codec means DefaultCodec.
+// The list is left unchanged if data is malformed.
+func (l *LinkedList[T]) DecodeBinary(data []byte, codec Codec[T]) error {
+	_, err := l.ReadStream(bytes.NewReader(data), BinaryStream(codec))
+	return err
+}
+
+// EncodeBinary encodes the tree with the given element codec, or with
+// DefaultCodec if codec is nil. The shape is stored as a bitmap with two
+// bits per node, followed by the values in pre-order.
+func (t *Tree[T]) EncodeBinary(codec Codec[T]) ([]byte, error) {
+	return encodeBinary(func(w io.Writer) (int64, error) {
+		return t.WriteStream(w, BinaryStream(codec))
+	})
+}
+
+// DecodeBinary replaces the contents of the tree with data produced by
+// EncodeBinary, using the same codec. A nil codec means DefaultCodec.
+// The compare function of the tree is kept, and the tree is left unchanged
+// if data is malformed.
+func (t *Tree[T]) DecodeBinary(data []byte, codec Codec[T]) error {
+	_, err := t.ReadStream(bytes.NewReader(data), BinaryStream(codec))
+	return err
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using DefaultCodec.
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return q.EncodeBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler using DefaultCodec.
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	return q.DecodeBinary(data, nil)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (q *Queue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using DefaultCodec.
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return l.EncodeBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler using DefaultCodec.
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.DecodeBinary(data, nil)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (l *LinkedList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (l *LinkedList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using DefaultCodec.
+func (t *Tree[T]) MarshalBinary() ([]byte, error) {
+	return t.EncodeBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler using DefaultCodec.
+func (t *Tree[T]) UnmarshalBinary(data []byte) error {
+	return t.DecodeBinary(data, nil)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format.
+func (t *Tree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary format.
+func (t *Tree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
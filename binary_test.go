@@ -0,0 +1,323 @@
+package collections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// Compile-time checks for the standard encoding interfaces.
+var (
+	_ encoding.BinaryMarshaler   = (*Queue[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*Queue[int])(nil)
+	_ gob.GobEncoder             = (*LinkedList[int])(nil)
+	_ gob.GobDecoder             = (*LinkedList[int])(nil)
+	_ encoding.BinaryMarshaler   = (*Tree[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*Tree[int])(nil)
+)
+
+func TestDefaultCodec(t *testing.T) {
+	checkCodecRoundTrip(t, DefaultCodec[int](), []int{0, -1, 1, math.MaxInt, math.MinInt})
+	checkCodecRoundTrip(t, DefaultCodec[int8](), []int8{-128, 127})
+	checkCodecRoundTrip(t, DefaultCodec[uint16](), []uint16{0, 65535})
+	checkCodecRoundTrip(t, DefaultCodec[uint64](), []uint64{0, math.MaxUint64})
+	checkCodecRoundTrip(t, DefaultCodec[float32](), []float32{0, -1.5, math.MaxFloat32})
+	checkCodecRoundTrip(t, DefaultCodec[float64](), []float64{0, math.Pi, math.Inf(-1)})
+	checkCodecRoundTrip(t, DefaultCodec[bool](), []bool{true, false})
+	checkCodecRoundTrip(t, DefaultCodec[string](), []string{"", "hello", "ünïcode"})
+
+	type point struct{ X, Y int }
+	checkCodecRoundTrip(t, DefaultCodec[point](), []point{{1, 2}, {-3, 4}})
+
+	// Small integers take a single byte
+	if encoded, _ := DefaultCodec[int]().Encode(-5); len(encoded) != 1 {
+		t.Errorf("Expected -5 to take 1 byte, got %d", len(encoded))
+	}
+
+	// Values that do not fit the target type are rejected
+	encoded, _ := DefaultCodec[int]().Encode(300)
+	if _, err := DefaultCodec[int8]().Decode(encoded); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for int8 overflow, got %v", err)
+	}
+	if _, err := DefaultCodec[bool]().Decode([]byte{2}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for bool 2, got %v", err)
+	}
+	if _, err := DefaultCodec[float64]().Decode([]byte{1, 2}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for short float64, got %v", err)
+	}
+}
+
+func checkCodecRoundTrip[T comparable](t *testing.T, codec Codec[T], values []T) {
+	t.Helper()
+	for _, value := range values {
+		encoded, err := codec.Encode(value)
+		if err != nil {
+			t.Fatalf("Encode(%v): unexpected error: %v", value, err)
+		}
+		decoded, err := codec.Decode(encoded)
+		if err != nil || decoded != value {
+			t.Errorf("Round trip of %v gave %v (error %v)", value, decoded, err)
+		}
+	}
+}
+
+func TestQueueBinary(t *testing.T) {
+	q := NewBoundedQueue[int](10)
+	q.EnqueueAll([]int{1, -2, 300})
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []byte{'C', 'L', 1, 1, 0, 10, 3, 1, 2, 1, 3, 2, 0xd8, 0x04}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("Expected %v, got %v", expected, data)
+	}
+
+	var decoded Queue[int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(decoded.ToSlice(), []int{1, -2, 300}) || decoded.capacity != 10 {
+		t.Errorf("Expected [1 -2 300] with capacity 10, got %v with capacity %d", decoded.ToSlice(), decoded.capacity)
+	}
+}
+
+func TestLinkedListBinary(t *testing.T) {
+	for _, circular := range []bool{false, true} {
+		list := NewLinkedListFrom("a", "bb", "")
+		if circular {
+			list.MakeCircular()
+		}
+
+		data, err := list.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		decoded := NewLinkedListFrom("old")
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !decoded.Equal(list, nil) || decoded.IsCircular() != circular {
+			t.Errorf("Expected %v, got %v", list, decoded)
+		}
+		if err := decoded.Validate(); err != nil {
+			t.Errorf("Decoded list is invalid: %v", err)
+		}
+	}
+}
+
+func TestTreeBinary(t *testing.T) {
+	trees := []*Tree[int]{newSampleTree(), NewTree[int]()}
+	trees = append(trees, mustTree(NewTreeFromLevelOrder([]int{1, -1, 2, 3}, -1)))
+	trees = append(trees, mustTree(NewBalancedBSTFromSorted([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})))
+
+	for _, tree := range trees {
+		data, err := tree.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		decoded := newSampleTree()
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error for %v: %v", tree.ToSlice(), err)
+		}
+		if !decoded.Equal(tree, nil) || decoded.Size() != tree.Size() {
+			t.Errorf("Expected %v, got %v", tree.ToSlice(), decoded.ToSlice())
+		}
+	}
+
+	// The sample tree needs two bytes of shape bitmap for seven nodes
+	data, _ := newSampleTree().MarshalBinary()
+	if !bytes.Equal(data[:8], []byte{'C', 'L', 1, 3, 0, 7, 0b10001111, 0b00001000}) {
+		t.Errorf("Unexpected header or shape %08b", data[:8])
+	}
+}
+
+func mustTree[T any](tree *Tree[T], err error) *Tree[T] {
+	if err != nil {
+		panic(err)
+	}
+	return tree
+}
+
+func TestBinaryCustomCodec(t *testing.T) {
+	// Store integers as decimal text
+	codec := NewCodec(
+		func(v int) ([]byte, error) { return []byte(strconv.Itoa(v)), nil },
+		func(data []byte) (int, error) { return strconv.Atoi(string(data)) },
+	)
+
+	tree := newSampleTree()
+	data, err := tree.EncodeBinary(codec)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := NewTree[int]()
+	if err := decoded.DecodeBinary(data, codec); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(tree, nil) {
+		t.Errorf("Expected %v, got %v", tree.ToSlice(), decoded.ToSlice())
+	}
+
+	// JSONCodec works the same way
+	list := NewLinkedListFrom(1.5, 2.5)
+	data, _ = list.EncodeBinary(JSONCodec[float64]())
+	decodedList := NewLinkedList[float64]()
+	if err := decodedList.DecodeBinary(data, JSONCodec[float64]()); err != nil || !decodedList.Equal(list, nil) {
+		t.Errorf("Expected %v, got %v (error %v)", list, decodedList, err)
+	}
+
+	// Encoding errors are returned
+	failing := NewCodec(
+		func(int) ([]byte, error) { return nil, errors.New("boom") },
+		func([]byte) (int, error) { return 0, nil },
+	)
+	if _, err := NewLinkedListFrom(1).EncodeBinary(failing); err == nil {
+		t.Error("Expected the codec error to be returned")
+	}
+}
+
+func TestGob(t *testing.T) {
+	type snapshot struct {
+		Pending *Queue[string]
+		History *LinkedList[int]
+		Plan    *Tree[int]
+	}
+
+	in := snapshot{NewQueue[string](), NewLinkedListFrom(3, 2, 1), newSampleTree()}
+	in.Pending.Enqueue("job")
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out snapshot
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !slices.Equal(out.Pending.ToSlice(), []string{"job"}) {
+		t.Errorf("Expected [job], got %v", out.Pending.ToSlice())
+	}
+	if !out.History.Equal(in.History, nil) {
+		t.Errorf("Expected %v, got %v", in.History, out.History)
+	}
+	if !out.Plan.Equal(in.Plan, nil) {
+		t.Errorf("Expected %v, got %v", in.Plan.ToSlice(), out.Plan.ToSlice())
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	listData, _ := NewLinkedListFrom(1, 2).MarshalBinary()
+	treeData, _ := newSampleTree().MarshalBinary()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"bad magic", []byte("XX\x01\x02\x00\x00")},
+		{"bad version", []byte("CL\x09\x02\x00\x00")},
+		{"wrong kind", []byte("CL\x01\x01\x00\x00\x00")},
+		{"unknown flags", []byte("CL\x01\x02\x80\x00")},
+		{"huge count", []byte("CL\x01\x02\x00\xff\xff\xff\xff\x0f")},
+		{"truncated", listData[:len(listData)-1]},
+		{"trailing bytes", append(slices.Clone(listData), 0)},
+	}
+
+	for _, tt := range tests {
+		list := NewLinkedListFrom(9)
+		if err := list.UnmarshalBinary(tt.data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: expected ErrInvalidEncoding, got %v", tt.name, err)
+		}
+		if !slices.Equal(list.ToSlice(), []int{9}) {
+			t.Errorf("%s: a failed decode should leave the list unchanged", tt.name)
+		}
+	}
+
+	// A shape that announces a child that never comes
+	broken := slices.Clone(treeData)
+	broken[5] = 6
+	if err := NewTree[int]().UnmarshalBinary(broken); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for a missing node, got %v", err)
+	}
+
+	// A queue with more values than its capacity
+	if err := NewQueue[int]().UnmarshalBinary([]byte("CL\x01\x01\x00\x01\x02\x01\x02\x01\x04")); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for an overfull queue, got %v", err)
+	}
+}
+
+func FuzzQueueUnmarshalBinary(f *testing.F) {
+	q := NewBoundedQueue[int](4)
+	q.EnqueueAll([]int{1, 2, 3})
+	seed, _ := q.MarshalBinary()
+	f.Add(seed)
+	f.Add([]byte("CL\x01\x01\x00\x00\x00"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded Queue[int]
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+		reencoded, err := decoded.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Re-encoding failed: %v", err)
+		}
+		var again Queue[int]
+		if err := again.UnmarshalBinary(reencoded); err != nil || !slices.Equal(again.ToSlice(), decoded.ToSlice()) {
+			t.Fatalf("Round trip changed %v into %v (error %v)", decoded.ToSlice(), again.ToSlice(), err)
+		}
+	})
+}
+
+func FuzzLinkedListUnmarshalBinary(f *testing.F) {
+	circular := NewCircularLinkedList[string]()
+	circular.AppendAll([]string{"a", "b"})
+	seed, _ := circular.MarshalBinary()
+	f.Add(seed)
+	seed, _ = NewLinkedListFrom("x").MarshalBinary()
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded LinkedList[string]
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+		if err := decoded.Validate(); err != nil {
+			t.Fatalf("Decoded list is invalid: %v", err)
+		}
+	})
+}
+
+func FuzzTreeUnmarshalBinary(f *testing.F) {
+	seed, _ := newSampleTree().MarshalBinary()
+	f.Add(seed)
+	seed, _ = NewTree[int]().MarshalBinary()
+	f.Add(seed)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded Tree[int]
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+		if len(decoded.ToSlice()) != decoded.Size() {
+			t.Fatalf("Decoded tree has %d nodes but size %d", len(decoded.ToSlice()), decoded.Size())
+		}
+		reencoded, err := decoded.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Re-encoding failed: %v", err)
+		}
+		var again Tree[int]
+		if err := again.UnmarshalBinary(reencoded); err != nil || !again.Equal(&decoded, nil) {
+			t.Fatalf("Round trip changed the tree (error %v)", err)
+		}
+	})
+}
@@ -0,0 +1,309 @@
+package collections
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// NewBST creates and returns a new empty binary search tree ordered by the
+// natural order of T.
+func NewBST[T cmp.Ordered]() *Tree[T] {
+	return &Tree[T]{compare: cmp.Compare[T]}
+}
+
+// NewBSTFunc creates and returns a new empty binary search tree ordered by compare.
+// compare must return a negative number when a < b, zero when a == b and a
+// positive number when a > b.
+func NewBSTFunc[T any](compare func(a, b T) int) *Tree[T] {
+	return &Tree[T]{compare: compare}
+}
+
+// SetCompare sets the function used by the BST methods to order values.
+// Use it to give an order to a tree of a type without a natural order, or to
+// replace the natural order of a tree that was not created with NewBST or
+// NewBSTFunc.
+func (t *Tree[T]) SetCompare(compare func(a, b T) int) {
+	t.compare = compare
+}
+
+// order returns the function the BST methods use to order values: the
+// compare function of the tree, or the natural order of T if the tree has
+// none. Returns nil if there is neither.
+func (t *Tree[T]) order() func(a, b T) int {
+	if t.compare != nil {
+		return t.compare
+	}
+	return naturalCompare[T]()
+}
+
+// naturalCompare returns a compare function for the natural order of T when
+// its underlying type is an integer, float or string type, including named
+// types such as type ID int. Returns nil for any other type.
+func naturalCompare[T any]() func(a, b T) int {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}
+	case reflect.String:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}
+	}
+	return nil
+}
+
+// InsertBST adds a value to the tree keeping the binary search tree order.
+// Returns false if the value is already in the tree, or if the tree has no
+// compare function and T has no natural order.
+func (t *Tree[T]) InsertBST(value T) bool {
+	compare := t.order()
+	if compare == nil {
+		return false
+	}
+
+	if t.root == nil {
+		t.root = NewNode(value)
+		t.size++
+		return true
+	}
+
+	current := t.root
+	for {
+		c := compare(value, current.Value)
+		switch {
+		case c == 0:
+			return false
+		case c < 0:
+			if current.Left == nil {
+				current.Left = NewNode(value)
+				t.size++
+				return true
+			}
+			current = current.Left
+		default:
+			if current.Right == nil {
+				current.Right = NewNode(value)
+				t.size++
+				return true
+			}
+			current = current.Right
+		}
+	}
+}
+
+// DeleteBST removes a value from a binary search tree.
+// A node with two children is replaced by its in-order successor.
+// Returns false if the value is not in the tree, or if the tree has no
+// compare function and T has no natural order.
+func (t *Tree[T]) DeleteBST(value T) bool {
+	compare := t.order()
+	if compare == nil {
+		return false
+	}
+
+	var parent *Node[T]
+	current := t.root
+	for current != nil {
+		c := compare(value, current.Value)
+		if c == 0 {
+			break
+		}
+		parent = current
+		if c < 0 {
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+	if current == nil {
+		return false
+	}
+
+	// Two children: copy the successor's value and delete the successor instead
+	if current.Left != nil && current.Right != nil {
+		successorParent := current
+		successor := current.Right
+		for successor.Left != nil {
+			successorParent = successor
+			successor = successor.Left
+		}
+		current.Value = successor.Value
+		parent = successorParent
+		current = successor
+	}
+
+	child := current.Left
+	if child == nil {
+		child = current.Right
+	}
+
+	switch {
+	case parent == nil:
+		t.root = child
+	case parent.Left == current:
+		parent.Left = child
+	default:
+		parent.Right = child
+	}
+
+	t.size--
+	return true
+}
+
+// SearchBST finds the node with the given value in O(h) time, where h is
+// the height of the tree.
+// Returns the node and true if found, nil and false otherwise, including
+// when the tree has no compare function and T has no natural order.
+func (t *Tree[T]) SearchBST(value T) (*Node[T], bool) {
+	compare := t.order()
+	if compare == nil {
+		return nil, false
+	}
+
+	current := t.root
+	for current != nil {
+		c := compare(value, current.Value)
+		switch {
+		case c == 0:
+			return current, true
+		case c < 0:
+			current = current.Left
+		default:
+			current = current.Right
+		}
+	}
+	return nil, false
+}
+
+// Min returns the smallest value of a binary search tree (the leftmost node).
+// Returns false if the tree is empty.
+func (t *Tree[T]) Min() (T, bool) {
+	var zero T
+
+	if t.root == nil {
+		return zero, false
+	}
+
+	current := t.root
+	for current.Left != nil {
+		current = current.Left
+	}
+	return current.Value, true
+}
+
+// Max returns the largest value of a binary search tree (the rightmost node).
+// Returns false if the tree is empty.
+func (t *Tree[T]) Max() (T, bool) {
+	var zero T
+
+	if t.root == nil {
+		return zero, false
+	}
+
+	current := t.root
+	for current.Right != nil {
+		current = current.Right
+	}
+	return current.Value, true
+}
+
+// Floor returns the greatest value less than or equal to value.
+// Returns false if there is no such value or no order to search by.
+func (t *Tree[T]) Floor(value T) (T, bool) {
+	return t.closest(value, true, true)
+}
+
+// Ceiling returns the smallest value greater than or equal to value.
+// Returns false if there is no such value or no order to search by.
+func (t *Tree[T]) Ceiling(value T) (T, bool) {
+	return t.closest(value, false, true)
+}
+
+// Predecessor returns the greatest value strictly less than value.
+// Returns false if there is no such value or no order to search by.
+func (t *Tree[T]) Predecessor(value T) (T, bool) {
+	return t.closest(value, true, false)
+}
+
+// Successor returns the smallest value strictly greater than value.
+// Returns false if there is no such value or no order to search by.
+func (t *Tree[T]) Successor(value T) (T, bool) {
+	return t.closest(value, false, false)
+}
+
+// closest walks down the tree looking for the nearest value below (or above)
+// the given value, optionally accepting an exact match.
+func (t *Tree[T]) closest(value T, below, inclusive bool) (T, bool) {
+	compare := t.order()
+	var result T
+	found := false
+	if compare == nil {
+		return result, false
+	}
+
+	current := t.root
+	for current != nil {
+		c := compare(current.Value, value)
+		if c == 0 && inclusive {
+			return current.Value, true
+		}
+
+		if below {
+			if c < 0 {
+				result, found = current.Value, true
+				current = current.Right
+			} else {
+				current = current.Left
+			}
+		} else {
+			if c > 0 {
+				result, found = current.Value, true
+				current = current.Left
+			} else {
+				current = current.Right
+			}
+		}
+	}
+	return result, found
+}
+
+// IsValidBST checks that every node is greater than all values in its left
+// subtree and less than all values in its right subtree. It works on any
+// tree, including one built with NewTree: values are ordered by the compare
+// function of the tree, or by the natural order of T if it has none.
+// Returns false if there is neither; use IsValidBSTFunc for such trees.
+func (t *Tree[T]) IsValidBST() bool {
+	compare := t.order()
+	if compare == nil {
+		return false
+	}
+	return t.IsValidBSTFunc(compare)
+}
+
+// IsValidBSTFunc checks that the tree is a binary search tree ordered by
+// compare, whatever order the tree itself uses. It is the way to check a
+// tree whose values have no natural order.
+func (t *Tree[T]) IsValidBSTFunc(compare func(a, b T) int) bool {
+	// An in-order walk of a BST visits values in strictly ascending order
+	valid := true
+	var prev T
+	first := true
+	t.WalkInOrder(func(value T) bool {
+		if !first && compare(prev, value) >= 0 {
+			valid = false
+			return false
+		}
+		prev, first = value, false
+		return true
+	})
+	return valid
+}
@@ -0,0 +1,311 @@
+package collections
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewBST(t *testing.T) {
+	tree := NewBST[int]()
+	if tree == nil {
+		t.Fatal("NewBST() returned nil")
+	}
+	if !tree.IsEmpty() {
+		t.Error("New BST should be empty")
+	}
+	if !tree.IsValidBST() {
+		t.Error("Empty tree should be a valid BST")
+	}
+}
+
+func TestInsertBST(t *testing.T) {
+	tree := NewBST[int]()
+	for _, val := range []int{5, 3, 8, 1, 4, 9} {
+		if !tree.InsertBST(val) {
+			t.Errorf("InsertBST(%d) should succeed", val)
+		}
+	}
+
+	if tree.InsertBST(4) {
+		t.Error("InsertBST of a duplicate should return false")
+	}
+	if tree.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", tree.Size())
+	}
+	if !tree.IsValidBST() {
+		t.Error("Tree should be a valid BST")
+	}
+
+	expected := []int{1, 3, 4, 5, 8, 9}
+	inOrder := tree.InOrder()
+	for i, val := range expected {
+		if inOrder[i] != val {
+			t.Errorf("Expected inOrder[%d] = %d, got %d", i, val, inOrder[i])
+		}
+	}
+}
+
+func TestDeleteBST(t *testing.T) {
+	//        5
+	//      /   \
+	//     3     8
+	//    / \   / \
+	//   1   4 7   9
+	tree := NewBST[int]()
+	for _, val := range []int{5, 3, 8, 1, 4, 7, 9} {
+		tree.InsertBST(val)
+	}
+
+	// Leaf
+	if !tree.DeleteBST(1) {
+		t.Error("DeleteBST(1) should succeed")
+	}
+	// Two children, successor is a leaf
+	if !tree.DeleteBST(8) {
+		t.Error("DeleteBST(8) should succeed")
+	}
+	// Root with two children
+	if !tree.DeleteBST(5) {
+		t.Error("DeleteBST(5) should succeed")
+	}
+	if tree.DeleteBST(42) {
+		t.Error("DeleteBST of a missing value should return false")
+	}
+
+	if tree.Size() != 4 {
+		t.Errorf("Expected size 4, got %d", tree.Size())
+	}
+	if !tree.IsValidBST() {
+		t.Error("Tree should be a valid BST after deletes")
+	}
+
+	expected := []int{3, 4, 7, 9}
+	inOrder := tree.InOrder()
+	for i, val := range expected {
+		if inOrder[i] != val {
+			t.Errorf("Expected inOrder[%d] = %d, got %d", i, val, inOrder[i])
+		}
+	}
+
+	for _, val := range expected {
+		tree.DeleteBST(val)
+	}
+	if !tree.IsEmpty() || tree.Size() != 0 {
+		t.Error("Tree should be empty after deleting every value")
+	}
+}
+
+func TestSearchBST(t *testing.T) {
+	tree := NewBST[int]()
+	for _, val := range []int{5, 3, 8} {
+		tree.InsertBST(val)
+	}
+
+	node, found := tree.SearchBST(8)
+	if !found || node.Value != 8 {
+		t.Error("SearchBST should find 8")
+	}
+	if _, found := tree.SearchBST(6); found {
+		t.Error("SearchBST should not find 6")
+	}
+}
+
+func TestBSTMinMax(t *testing.T) {
+	tree := NewBST[int]()
+	if _, ok := tree.Min(); ok {
+		t.Error("Min on empty tree should fail")
+	}
+	if _, ok := tree.Max(); ok {
+		t.Error("Max on empty tree should fail")
+	}
+
+	for _, val := range []int{5, 3, 8, 1, 9} {
+		tree.InsertBST(val)
+	}
+	if val, _ := tree.Min(); val != 1 {
+		t.Errorf("Expected min 1, got %d", val)
+	}
+	if val, _ := tree.Max(); val != 9 {
+		t.Errorf("Expected max 9, got %d", val)
+	}
+}
+
+func TestBSTFloorCeiling(t *testing.T) {
+	tree := NewBST[int]()
+	for _, val := range []int{20, 10, 30, 25} {
+		tree.InsertBST(val)
+	}
+
+	tests := []struct {
+		name   string
+		fn     func(int) (int, bool)
+		input  int
+		want   int
+		wantOk bool
+	}{
+		{"Floor exact", tree.Floor, 25, 25, true},
+		{"Floor between", tree.Floor, 27, 25, true},
+		{"Floor below min", tree.Floor, 5, 0, false},
+		{"Ceiling exact", tree.Ceiling, 10, 10, true},
+		{"Ceiling between", tree.Ceiling, 21, 25, true},
+		{"Ceiling above max", tree.Ceiling, 31, 0, false},
+		{"Predecessor", tree.Predecessor, 25, 20, true},
+		{"Predecessor of min", tree.Predecessor, 10, 0, false},
+		{"Successor", tree.Successor, 20, 25, true},
+		{"Successor of max", tree.Successor, 30, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.fn(tt.input)
+		if ok != tt.wantOk || got != tt.want {
+			t.Errorf("%s(%d) = %d, %v; expected %d, %v", tt.name, tt.input, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestIsValidBST(t *testing.T) {
+	// Level-order insertion does not keep BST order
+	tree := NewTree[int]()
+	tree.Insert(1)
+	tree.Insert(2)
+	tree.Insert(3)
+	tree.SetCompare(func(a, b int) int { return a - b })
+
+	if tree.IsValidBST() {
+		t.Error("Level-order tree [1 2 3] should not be a valid BST")
+	}
+
+	// Violation deeper than the direct parent
+	//       5
+	//      / \
+	//     3   8
+	//      \
+	//       6
+	bad := NewBST[int]()
+	bad.root = NewNode(5)
+	bad.root.Left = NewNode(3)
+	bad.root.Right = NewNode(8)
+	bad.root.Left.Right = NewNode(6)
+	bad.size = 4
+
+	if bad.IsValidBST() {
+		t.Error("Tree with 6 in the left subtree of 5 should not be a valid BST")
+	}
+}
+
+func TestBSTWithComparator(t *testing.T) {
+	tree := NewBSTFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	tree.InsertBST("banana")
+	tree.InsertBST("Apple")
+	tree.InsertBST("cherry")
+
+	if tree.InsertBST("APPLE") {
+		t.Error("InsertBST should use the comparator to detect duplicates")
+	}
+	if val, _ := tree.Min(); val != "Apple" {
+		t.Errorf("Expected min 'Apple', got '%s'", val)
+	}
+}
+
+func TestBSTRandomOperations(t *testing.T) {
+	tree := NewBST[int]()
+	reference := map[int]bool{}
+	rng := rand.New(rand.NewPCG(3, 3))
+
+	for range 1000 {
+		val := rng.IntN(100)
+		if rng.IntN(2) == 0 {
+			if tree.InsertBST(val) == reference[val] {
+				t.Fatalf("InsertBST(%d) disagreed with reference", val)
+			}
+			reference[val] = true
+		} else {
+			if tree.DeleteBST(val) != reference[val] {
+				t.Fatalf("DeleteBST(%d) disagreed with reference", val)
+			}
+			delete(reference, val)
+		}
+	}
+
+	if !tree.IsValidBST() {
+		t.Fatal("Tree should be a valid BST after random operations")
+	}
+
+	expected := make([]int, 0, len(reference))
+	for val := range reference {
+		expected = append(expected, val)
+	}
+	slices.Sort(expected)
+
+	if !slices.Equal(tree.InOrder(), expected) {
+		t.Errorf("Expected in-order %v, got %v", expected, tree.InOrder())
+	}
+	if tree.Size() != len(expected) {
+		t.Errorf("Expected size %d, got %d", len(expected), tree.Size())
+	}
+}
+
+func TestBSTWithoutCompare(t *testing.T) {
+	// A level-order tree [2 1 3] happens to be in BST order, and int has a
+	// natural order
+	tree := NewTree[int]()
+	tree.Insert(2)
+	tree.Insert(1)
+	tree.Insert(3)
+
+	if !tree.IsValidBST() {
+		t.Error("IsValidBST should accept the tree [2 1 3] in natural order")
+	}
+	if tree.IsValidBSTFunc(func(a, b int) int { return b - a }) {
+		t.Error("IsValidBSTFunc should reject the tree [2 1 3] in reverse order")
+	}
+	if node, ok := tree.SearchBST(1); !ok || node.Value != 1 {
+		t.Error("SearchBST should use the natural order")
+	}
+	if v, ok := tree.Floor(4); !ok || v != 3 {
+		t.Errorf("Expected Floor(4) = 3, got %d", v)
+	}
+	if !tree.InsertBST(4) || !tree.DeleteBST(2) || !tree.IsValidBST() {
+		t.Error("InsertBST and DeleteBST should use the natural order")
+	}
+
+	// Named types keep the order of their underlying type
+	type score float64
+	scores := NewTree[score]()
+	scores.Insert(2.5)
+	scores.Insert(-1)
+	scores.Insert(10)
+	if !scores.IsValidBST() || !scores.InsertBST(3) || !scores.IsValidBST() {
+		t.Error("A named float type should use its natural order")
+	}
+
+	// Without an order everything reports false instead of panicking
+	type point struct{ x, y int }
+	points := NewTree[point]()
+	points.Insert(point{1, 2})
+	if points.IsValidBST() || points.InsertBST(point{3, 4}) || points.DeleteBST(point{1, 2}) {
+		t.Error("BST methods should return false without an order")
+	}
+	if _, ok := points.SearchBST(point{1, 2}); ok {
+		t.Error("SearchBST should return false without an order")
+	}
+	for name, query := range map[string]func(point) (point, bool){
+		"Floor": points.Floor, "Ceiling": points.Ceiling, "Predecessor": points.Predecessor, "Successor": points.Successor,
+	} {
+		if _, ok := query(point{1, 2}); ok {
+			t.Errorf("%s should return false without an order", name)
+		}
+	}
+	if points.Size() != 1 {
+		t.Errorf("The tree should be unchanged, got size %d", points.Size())
+	}
+	byX := func(a, b point) int { return cmp.Compare(a.x, b.x) }
+	if !points.IsValidBSTFunc(byX) {
+		t.Error("IsValidBSTFunc should check trees without an order")
+	}
+}
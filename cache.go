@@ -0,0 +1,138 @@
+package collections
+
+import "time"
+
+// Cache is a fixed-size key/value store that evicts entries when it is full.
+// LRUCache, SyncLRUCache, LFUCache and ARCCache differ in which entry they
+// evict.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for a key and records the use for the eviction
+	// policy. Returns false if the key is not in the cache.
+	Get(key K) (V, bool)
+
+	// Peek returns the value for a key without recording a use.
+	// Returns false if the key is not in the cache.
+	Peek(key K) (V, bool)
+
+	// Put adds or replaces the value for a key, evicting an entry if the
+	// cache is full. Returns true if a new key was added.
+	Put(key K, value V) bool
+
+	// Remove removes a key from the cache.
+	// Returns false if the key is not in the cache.
+	Remove(key K) bool
+
+	// Contains checks if the key is in the cache without recording a use.
+	Contains(key K) bool
+
+	// Len returns the number of entries in the cache.
+	Len() int
+
+	// Capacity returns the maximum number of entries the cache holds.
+	Capacity() int
+
+	// Clear removes all entries from the cache.
+	Clear()
+
+	// OnEvict sets a function called with every entry evicted to make room.
+	OnEvict(fn func(key K, value V))
+
+	// Stats returns the counters kept by the cache.
+	Stats() CacheStats
+
+	// String returns a string representation of the cache.
+	String() string
+}
+
+// Compile-time checks that each cache implements Cache.
+var (
+	_ Cache[string, int] = (*LRUCache[string, int])(nil)
+	_ Cache[string, int] = (*SyncLRUCache[string, int])(nil)
+	_ Cache[string, int] = (*LFUCache[string, int])(nil)
+	_ Cache[string, int] = (*ARCCache[string, int])(nil)
+)
+
+// CacheStats holds the counters kept by a cache.
+type CacheStats struct {
+	// Hits is the number of lookups that found a live entry.
+	Hits int
+
+	// Misses is the number of lookups that found nothing or an expired entry.
+	Misses int
+
+	// Evictions is the number of entries removed to make room.
+	Evictions int
+
+	// Expirations is the number of entries removed because their TTL ran
+	// out. Only LRUCache supports TTLs.
+	Expirations int
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 if there
+// were no lookups.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// cacheEntry is a node of a doubly linked entryList.
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time // LRUCache only; zero means the entry never expires
+	freq    int       // LFUCache only
+	owner   *entryList[K, V]
+	prev    *cacheEntry[K, V]
+	next    *cacheEntry[K, V]
+}
+
+// entryList is a circular doubly linked list of cache entries with a
+// sentinel root, so entries can be moved and removed in O(1) time.
+// The front is root.next and the back is root.prev.
+type entryList[K comparable, V any] struct {
+	root cacheEntry[K, V]
+	len  int
+}
+
+func (l *entryList[K, V]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+}
+
+func (l *entryList[K, V]) pushFront(e *cacheEntry[K, V]) {
+	e.owner = l
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+	l.len++
+}
+
+func (l *entryList[K, V]) remove(e *cacheEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.owner = nil
+	e.prev = nil
+	e.next = nil
+	l.len--
+}
+
+func (l *entryList[K, V]) moveToFront(e *cacheEntry[K, V]) {
+	if l.root.next == e {
+		return
+	}
+	l.remove(e)
+	l.pushFront(e)
+}
+
+// back returns the last entry, or nil if the list is empty.
+func (l *entryList[K, V]) back() *cacheEntry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
@@ -0,0 +1,95 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func newCaches(capacity int) map[string]Cache[string, int] {
+	return map[string]Cache[string, int]{
+		"LRUCache":     NewLRUCache[string, int](capacity),
+		"SyncLRUCache": NewSyncLRUCache[string, int](capacity),
+		"LFUCache":     NewLFUCache[string, int](capacity),
+		"ARCCache":     NewARCCache[string, int](capacity),
+	}
+}
+
+func TestCacheContract(t *testing.T) {
+	for name, c := range newCaches(2) {
+		if c.Capacity() != 2 || c.Len() != 0 {
+			t.Errorf("%s: expected an empty cache with capacity 2", name)
+		}
+
+		var evicted []string
+		c.OnEvict(func(key string, _ int) { evicted = append(evicted, key) })
+
+		if !c.Put("a", 1) || !c.Put("b", 2) || c.Put("a", 10) {
+			t.Errorf("%s: Put should report new keys", name)
+		}
+		if v, ok := c.Get("a"); !ok || v != 10 {
+			t.Errorf("%s: expected 10, got %d", name, v)
+		}
+		if v, ok := c.Peek("b"); !ok || v != 2 {
+			t.Errorf("%s: expected 2, got %d", name, v)
+		}
+		if _, ok := c.Get("missing"); ok || c.Contains("missing") {
+			t.Errorf("%s: missing key should not be found", name)
+		}
+
+		// "a" has been used more and more recently than "b"
+		c.Put("c", 3)
+		if c.Len() != 2 || !slices.Equal(evicted, []string{"b"}) {
+			t.Errorf("%s: expected b to be evicted, got %v", name, evicted)
+		}
+		if !c.Contains("a") || !c.Contains("c") {
+			t.Errorf("%s: expected a and c to stay", name)
+		}
+
+		if !c.Remove("a") || c.Remove("a") || c.Len() != 1 {
+			t.Errorf("%s: Remove should succeed once", name)
+		}
+		c.Clear()
+		if c.Len() != 0 || len(evicted) != 1 {
+			t.Errorf("%s: Clear should empty the cache without callbacks", name)
+		}
+
+		stats := c.Stats()
+		if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 {
+			t.Errorf("%s: unexpected stats %+v", name, stats)
+		}
+		if c.String() == "" {
+			t.Errorf("%s: String should not be empty", name)
+		}
+	}
+}
+
+func TestCacheRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for name, c := range newCaches(16) {
+		live := map[string]int{}
+		c.OnEvict(func(key string, _ int) { delete(live, key) })
+
+		for i := range 5000 {
+			key := string(rune('a' + r.IntN(40)))
+			switch r.IntN(4) {
+			case 0, 1:
+				if v, ok := c.Get(key); ok != (live[key] != 0) || v != live[key] {
+					t.Fatalf("%s: step %d: Get(%s) = %d, %v; expected %d", name, i, key, v, ok, live[key])
+				}
+			case 2:
+				c.Put(key, i+1)
+				live[key] = i + 1
+			case 3:
+				if c.Remove(key) != (live[key] != 0) {
+					t.Fatalf("%s: step %d: Remove(%s) disagrees with the model", name, i, key)
+				}
+				delete(live, key)
+			}
+
+			if c.Len() != len(live) || c.Len() > c.Capacity() {
+				t.Fatalf("%s: step %d: Len() = %d, expected %d", name, i, c.Len(), len(live))
+			}
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Codec converts single values to and from bytes for the binary and
+// streaming encodings. The encodings store the length of every value, so a
+// codec does not need to mark where a value ends.
+type Codec[T any] interface {
+	// Encode returns the bytes for value.
+	Encode(value T) ([]byte, error)
+
+	// Decode rebuilds a value from all of data.
+	Decode(data []byte) (T, error)
+}
+
+type funcCodec[T any] struct {
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
+}
+
+func (c funcCodec[T]) Encode(value T) ([]byte, error) { return c.encode(value) }
+func (c funcCodec[T]) Decode(data []byte) (T, error)  { return c.decode(data) }
+
+// NewCodec creates a Codec from a pair of functions.
+func NewCodec[T any](encode func(T) ([]byte, error), decode func([]byte) (T, error)) Codec[T] {
+	return funcCodec[T]{encode, decode}
+}
+
+// GobCodec returns a Codec that encodes every value with encoding/gob.
+// It works with most types but repeats the type description in every value.
+func GobCodec[T any]() Codec[T] {
+	return NewCodec(
+		func(value T) ([]byte, error) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		},
+		func(data []byte) (T, error) {
+			var value T
+			reader := bytes.NewReader(data)
+			if err := gob.NewDecoder(reader).Decode(&value); err != nil {
+				return value, err
+			}
+			if reader.Len() != 0 {
+				return value, fmt.Errorf("%w: %d bytes after gob value", ErrInvalidEncoding, reader.Len())
+			}
+			return value, nil
+		},
+	)
+}
+
+// JSONCodec returns a Codec that encodes every value with encoding/json.
+func JSONCodec[T any]() Codec[T] {
+	return NewCodec(
+		func(value T) ([]byte, error) { return json.Marshal(value) },
+		func(data []byte) (T, error) {
+			var value T
+			err := json.Unmarshal(data, &value)
+			return value, err
+		},
+	)
+}
+
+// DefaultCodec returns the Codec used when nil is passed to an encoding
+// method. Integers are stored as varints, floats by their IEEE 754 bits,
+// bools as one byte and strings and byte slices as they are. Any other type,
+// including named types such as type ID int, falls back to GobCodec.
+func DefaultCodec[T any]() Codec[T] {
+	var zero T
+	switch any(zero).(type) {
+	case int:
+		return signedCodec[T, int]()
+	case int8:
+		return signedCodec[T, int8]()
+	case int16:
+		return signedCodec[T, int16]()
+	case int32:
+		return signedCodec[T, int32]()
+	case int64:
+		return signedCodec[T, int64]()
+	case uint:
+		return unsignedCodec[T, uint]()
+	case uint8:
+		return unsignedCodec[T, uint8]()
+	case uint16:
+		return unsignedCodec[T, uint16]()
+	case uint32:
+		return unsignedCodec[T, uint32]()
+	case uint64:
+		return unsignedCodec[T, uint64]()
+	case uintptr:
+		return unsignedCodec[T, uintptr]()
+	case float32:
+		return NewCodec(
+			func(value T) ([]byte, error) {
+				return binary.LittleEndian.AppendUint32(nil, math.Float32bits(any(value).(float32))), nil
+			},
+			func(data []byte) (T, error) {
+				if len(data) != 4 {
+					return zero, fmt.Errorf("%w: float32 needs 4 bytes, got %d", ErrInvalidEncoding, len(data))
+				}
+				return any(math.Float32frombits(binary.LittleEndian.Uint32(data))).(T), nil
+			},
+		)
+	case float64:
+		return NewCodec(
+			func(value T) ([]byte, error) {
+				return binary.LittleEndian.AppendUint64(nil, math.Float64bits(any(value).(float64))), nil
+			},
+			func(data []byte) (T, error) {
+				if len(data) != 8 {
+					return zero, fmt.Errorf("%w: float64 needs 8 bytes, got %d", ErrInvalidEncoding, len(data))
+				}
+				return any(math.Float64frombits(binary.LittleEndian.Uint64(data))).(T), nil
+			},
+		)
+	case bool:
+		return NewCodec(
+			func(value T) ([]byte, error) {
+				if any(value).(bool) {
+					return []byte{1}, nil
+				}
+				return []byte{0}, nil
+			},
+			func(data []byte) (T, error) {
+				if len(data) != 1 || data[0] > 1 {
+					return zero, fmt.Errorf("%w: invalid bool", ErrInvalidEncoding)
+				}
+				return any(data[0] == 1).(T), nil
+			},
+		)
+	case string:
+		return NewCodec(
+			func(value T) ([]byte, error) { return []byte(any(value).(string)), nil },
+			func(data []byte) (T, error) { return any(string(data)).(T), nil },
+		)
+	case []byte:
+		return NewCodec(
+			func(value T) ([]byte, error) { return any(value).([]byte), nil },
+			func(data []byte) (T, error) { return any(bytes.Clone(data)).(T), nil },
+		)
+	}
+	return GobCodec[T]()
+}
+
+func signedCodec[T any, I int | int8 | int16 | int32 | int64]() Codec[T] {
+	return NewCodec(
+		func(value T) ([]byte, error) {
+			return binary.AppendVarint(nil, int64(any(value).(I))), nil
+		},
+		func(data []byte) (T, error) {
+			var zero T
+			n, size := binary.Varint(data)
+			if size <= 0 || size != len(data) {
+				return zero, fmt.Errorf("%w: invalid varint", ErrInvalidEncoding)
+			}
+			if int64(I(n)) != n {
+				return zero, fmt.Errorf("%w: %d overflows %T", ErrInvalidEncoding, n, zero)
+			}
+			return any(I(n)).(T), nil
+		},
+	)
+}
+
+func unsignedCodec[T any, U uint | uint8 | uint16 | uint32 | uint64 | uintptr]() Codec[T] {
+	return NewCodec(
+		func(value T) ([]byte, error) {
+			return binary.AppendUvarint(nil, uint64(any(value).(U))), nil
+		},
+		func(data []byte) (T, error) {
+			var zero T
+			n, size := binary.Uvarint(data)
+			if size <= 0 || size != len(data) {
+				return zero, fmt.Errorf("%w: invalid varint", ErrInvalidEncoding)
+			}
+			if uint64(U(n)) != n {
+				return zero, fmt.Errorf("%w: %d overflows %T", ErrInvalidEncoding, n, zero)
+			}
+			return any(U(n)).(T), nil
+		},
+	)
+}
+
+// EntryCodec returns a Codec for the key/value pairs of a TreeMap or
+// SkipList. It stores the length of the encoded key, the key and then the
+// value. A nil codec means DefaultCodec for that part.
+func EntryCodec[K, V any](keys Codec[K], values Codec[V]) Codec[Entry[K, V]] {
+	keys, values = orDefault(keys), orDefault(values)
+	return NewCodec(
+		func(entry Entry[K, V]) ([]byte, error) {
+			key, err := keys.Encode(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := values.Encode(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			buf := binary.AppendUvarint(nil, uint64(len(key)))
+			buf = append(buf, key...)
+			return append(buf, value...), nil
+		},
+		func(data []byte) (Entry[K, V], error) {
+			var entry Entry[K, V]
+			n, size := binary.Uvarint(data)
+			if size <= 0 || n > uint64(len(data)-size) {
+				return entry, fmt.Errorf("%w: invalid key length", ErrInvalidEncoding)
+			}
+			data = data[size:]
+			key, err := keys.Decode(data[:n])
+			if err != nil {
+				return entry, err
+			}
+			value, err := values.Decode(data[n:])
+			if err != nil {
+				return entry, err
+			}
+			return Entry[K, V]{key, value}, nil
+		},
+	)
+}
+
+// orDefault returns codec, or DefaultCodec if codec is nil.
+func orDefault[T any](codec Codec[T]) Codec[T] {
+	if codec == nil {
+		return DefaultCodec[T]()
+	}
+	return codec
+}
@@ -0,0 +1,99 @@
+package collections
+
+// Container is the set of methods shared by every collection that holds
+// single values. Key/value collections such as TreeMap and SkipList are not
+// Containers because their elements are pairs.
+type Container[T any] interface {
+	// Len returns the number of values in the container.
+	Len() int
+
+	// IsEmpty returns true if the container holds no values.
+	IsEmpty() bool
+
+	// Clear removes all values from the container.
+	Clear()
+
+	// ToSlice returns the values of the container in its natural order.
+	ToSlice() []T
+
+	// String returns a string representation of the container.
+	String() string
+}
+
+// Sequence is a Container whose values have positions, starting at index 0.
+type Sequence[T any] interface {
+	Container[T]
+
+	// Get returns the value at the given index.
+	// Returns false if the index is out of range.
+	Get(index int) (T, bool)
+
+	// IndexOf returns the index of the first occurrence of value, or -1.
+	IndexOf(value T) int
+
+	// ForEach calls fn for every value in order.
+	ForEach(fn func(T))
+}
+
+// FIFO is a Container that returns values in the order they were added
+// (First-In-First-Out).
+type FIFO[T any] interface {
+	Container[T]
+
+	// Enqueue adds a value to the back.
+	// Returns false if the value could not be added.
+	Enqueue(value T) bool
+
+	// Dequeue removes and returns the value at the front.
+	// Returns false if the container is empty.
+	Dequeue() (T, bool)
+
+	// Peek returns the value at the front without removing it.
+	// Returns false if the container is empty.
+	Peek() (T, bool)
+}
+
+// LIFO is a Container that returns the most recently added value first
+// (Last-In-First-Out), like a stack.
+type LIFO[T any] interface {
+	Container[T]
+
+	// Push adds a value to the top.
+	Push(value T)
+
+	// Pop removes and returns the value at the top.
+	// Returns false if the container is empty.
+	Pop() (T, bool)
+
+	// Peek returns the value at the top without removing it.
+	// Returns false if the container is empty.
+	Peek() (T, bool)
+}
+
+// Searchable is a Container that can report whether it holds a value.
+type Searchable[T any] interface {
+	Container[T]
+
+	// Contains returns true if the value is in the container.
+	Contains(value T) bool
+}
+
+// Compile-time checks that each collection implements its interfaces.
+var (
+	_ FIFO[int]       = (*Queue[int])(nil)
+	_ Searchable[int] = (*Queue[int])(nil)
+
+	_ Sequence[int]   = (*LinkedList[int])(nil)
+	_ FIFO[int]       = (*LinkedList[int])(nil)
+	_ LIFO[int]       = (*LinkedList[int])(nil)
+	_ Searchable[int] = (*LinkedList[int])(nil)
+
+	_ Sequence[int]   = (*UnrolledList[int])(nil)
+	_ Searchable[int] = (*UnrolledList[int])(nil)
+
+	_ Searchable[int] = (*Tree[int])(nil)
+	_ Searchable[int] = (*AVLTree[int])(nil)
+	_ Searchable[int] = (*OrderStatisticTree[int])(nil)
+	_ Searchable[int] = (*TreeSet[int])(nil)
+	_ Searchable[int] = (*Set[int])(nil)
+)
@@ -0,0 +1,151 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+// drainFIFO removes every value from q in the order it hands them out.
+func drainFIFO[T any](q FIFO[T]) []T {
+	result := []T{}
+	for {
+		value, ok := q.Dequeue()
+		if !ok {
+			return result
+		}
+		result = append(result, value)
+	}
+}
+
+func TestFIFO(t *testing.T) {
+	implementations := map[string]FIFO[int]{
+		"Queue":      NewQueue[int](),
+		"LinkedList": NewLinkedList[int](),
+	}
+
+	for name, q := range implementations {
+		for i := 1; i <= 3; i++ {
+			if !q.Enqueue(i) {
+				t.Errorf("%s: Enqueue(%d) should succeed", name, i)
+			}
+		}
+		if next, ok := q.Peek(); !ok || next != 1 {
+			t.Errorf("%s: expected Peek to return 1, got %d", name, next)
+		}
+		if got := drainFIFO(q); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("%s: expected [1 2 3], got %v", name, got)
+		}
+		if _, ok := q.Peek(); ok {
+			t.Errorf("%s: Peek on an empty container should fail", name)
+		}
+	}
+}
+
+func TestLIFO(t *testing.T) {
+	var stack LIFO[int] = NewLinkedList[int]()
+	for i := 1; i <= 3; i++ {
+		stack.Push(i)
+	}
+
+	if top, ok := stack.Peek(); !ok || top != 3 {
+		t.Errorf("Expected Peek to return 3, got %d", top)
+	}
+	result := []int{}
+	for !stack.IsEmpty() {
+		value, _ := stack.Pop()
+		result = append(result, value)
+	}
+	if !slices.Equal(result, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", result)
+	}
+	if _, ok := stack.Pop(); ok {
+		t.Error("Pop on an empty stack should fail")
+	}
+}
+
+func TestSequence(t *testing.T) {
+	list := NewLinkedListFrom(10, 20, 30)
+	unrolled := NewUnrolledListSize[int](2)
+	for _, v := range []int{10, 20, 30} {
+		unrolled.Append(v)
+	}
+
+	for name, seq := range map[string]Sequence[int]{"LinkedList": list, "UnrolledList": unrolled} {
+		if value, ok := seq.Get(1); !ok || value != 20 {
+			t.Errorf("%s: expected Get(1) to return 20, got %d", name, value)
+		}
+		if index := seq.IndexOf(30); index != 2 {
+			t.Errorf("%s: expected IndexOf(30) to return 2, got %d", name, index)
+		}
+		sum := 0
+		seq.ForEach(func(v int) { sum += v })
+		if sum != 60 {
+			t.Errorf("%s: expected ForEach sum 60, got %d", name, sum)
+		}
+	}
+}
+
+func TestSearchable(t *testing.T) {
+	values := []int{5, 3, 8, 1}
+	implementations := map[string]Searchable[int]{}
+
+	queue := NewQueue[int]()
+	list := NewLinkedList[int]()
+	unrolled := NewUnrolledList[int]()
+	tree := NewTree[int]()
+	avl := NewAVLTree[int]()
+	ost := NewOrderStatisticTree[int]()
+	set := NewTreeSet[int]()
+	for _, v := range values {
+		queue.Enqueue(v)
+		list.Append(v)
+		unrolled.Append(v)
+		tree.Insert(v)
+		avl.Insert(v)
+		ost.Insert(v)
+		set.Add(v)
+	}
+	implementations["Queue"] = queue
+	implementations["LinkedList"] = list
+	implementations["UnrolledList"] = unrolled
+	implementations["Tree"] = tree
+	implementations["AVLTree"] = avl
+	implementations["OrderStatisticTree"] = ost
+	implementations["TreeSet"] = set
+
+	for name, c := range implementations {
+		if c.Len() != len(values) {
+			t.Errorf("%s: expected Len %d, got %d", name, len(values), c.Len())
+		}
+		if !c.Contains(8) || c.Contains(7) {
+			t.Errorf("%s: Contains gave the wrong answer", name)
+		}
+
+		got := c.ToSlice()
+		slices.Sort(got)
+		if !slices.Equal(got, []int{1, 3, 5, 8}) {
+			t.Errorf("%s: expected ToSlice to hold [1 3 5 8], got %v", name, got)
+		}
+
+		c.Clear()
+		if !c.IsEmpty() || c.Len() != 0 {
+			t.Errorf("%s: container should be empty after Clear", name)
+		}
+	}
+}
+
+func TestContainerToSliceOrder(t *testing.T) {
+	tree := NewTree[int]()
+	avl := NewAVLTree[int]()
+	for _, v := range []int{5, 3, 8, 1} {
+		tree.Insert(v)
+		avl.Insert(v)
+	}
+
+	if !slices.Equal(tree.ToSlice(), tree.LevelOrder()) {
+		t.Errorf("Tree.ToSlice should use level order, got %v", tree.ToSlice())
+	}
+	if !slices.Equal(avl.ToSlice(), []int{1, 3, 5, 8}) {
+		t.Errorf("AVLTree.ToSlice should be sorted, got %v", avl.ToSlice())
+	}
+}
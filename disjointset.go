@@ -0,0 +1,408 @@
+package collections
+
+import "fmt"
+
+// DisjointSet represents a collection of disjoint sets, also known as
+// union-find. Each element belongs to exactly one set, and sets can only be
+// merged. It uses union by rank and path compression, so Find, Union and
+// Connected take nearly constant time.
+//
+// Elements are kept in the order they were added, which fixes the order of
+// Groups and String.
+type DisjointSet[T comparable] struct {
+	index  map[T]int
+	items  []T
+	parent []int
+	rank   []int // upper bound on the height of the tree under each root
+	size   []int // number of elements in the set, only valid for roots
+	count  int
+}
+
+// NewDisjointSet creates and returns a new empty disjoint set.
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: make(map[T]int)}
+}
+
+// add places a new element in a set of its own and returns its index.
+func (d *DisjointSet[T]) add(x T) int {
+	i := len(d.items)
+	d.index[x] = i
+	d.items = append(d.items, x)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	d.count++
+	return i
+}
+
+// MakeSet adds an element in a set of its own.
+// Returns false if the element is already in a set.
+func (d *DisjointSet[T]) MakeSet(x T) bool {
+	if _, ok := d.index[x]; ok {
+		return false
+	}
+	d.add(x)
+	return true
+}
+
+// root returns the root of the tree holding i, pointing every element on the
+// way straight at the root.
+func (d *DisjointSet[T]) root(i int) int {
+	r := i
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+	for d.parent[i] != r {
+		d.parent[i], i = r, d.parent[i]
+	}
+	return r
+}
+
+// Find returns the representative of the set holding x. Two elements are in
+// the same set exactly when they have the same representative. The
+// representative can change after a Union.
+// Returns false if x is not in any set.
+func (d *DisjointSet[T]) Find(x T) (T, bool) {
+	i, ok := d.index[x]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return d.items[d.root(i)], true
+}
+
+// Union merges the sets holding x and y, first adding either element in a
+// set of its own if it is not in any set.
+// Returns false if x and y were already in the same set.
+func (d *DisjointSet[T]) Union(x, y T) bool {
+	i, ok := d.index[x]
+	if !ok {
+		i = d.add(x)
+	}
+	j, ok := d.index[y]
+	if !ok {
+		j = d.add(y)
+	}
+
+	ri, rj := d.root(i), d.root(j)
+	if ri == rj {
+		return false
+	}
+	// Attach the shorter tree under the taller one
+	if d.rank[ri] < d.rank[rj] {
+		ri, rj = rj, ri
+	}
+	d.parent[rj] = ri
+	d.size[ri] += d.size[rj]
+	if d.rank[ri] == d.rank[rj] {
+		d.rank[ri]++
+	}
+	d.count--
+	return true
+}
+
+// Connected checks if x and y are in the same set.
+// Returns false if either element is not in any set.
+func (d *DisjointSet[T]) Connected(x, y T) bool {
+	i, ok := d.index[x]
+	j, ok2 := d.index[y]
+	return ok && ok2 && d.root(i) == d.root(j)
+}
+
+// SetSize returns the number of elements in the set holding x.
+// Returns 0 if x is not in any set.
+func (d *DisjointSet[T]) SetSize(x T) int {
+	i, ok := d.index[x]
+	if !ok {
+		return 0
+	}
+	return d.size[d.root(i)]
+}
+
+// Count returns the number of sets.
+func (d *DisjointSet[T]) Count() int {
+	return d.count
+}
+
+// Len returns the number of elements in all sets.
+func (d *DisjointSet[T]) Len() int {
+	return len(d.items)
+}
+
+// IsEmpty returns true if there are no elements.
+func (d *DisjointSet[T]) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+// Clear removes all elements and sets.
+func (d *DisjointSet[T]) Clear() {
+	d.index = make(map[T]int)
+	d.items = nil
+	d.parent = nil
+	d.rank = nil
+	d.size = nil
+	d.count = 0
+}
+
+// Groups returns the elements of every set. Sets are ordered by their
+// first-added element, and the elements of each set are in the order they
+// were added.
+func (d *DisjointSet[T]) Groups() [][]T {
+	return groupItems(d.items, d.root)
+}
+
+// String returns a string representation of the sets in the order of
+// Groups, like "DisjointSet{{a, b}, {c}}".
+func (d *DisjointSet[T]) String() string {
+	return "DisjointSet" + formatGroups(d.Groups())
+}
+
+// groupItems groups the items by the root that find returns for their
+// index. Sets are ordered by their first item.
+func groupItems[T any](items []T, find func(int) int) [][]T {
+	if len(items) == 0 {
+		return nil
+	}
+
+	position := make(map[int]int) // root -> index in groups
+	var groups [][]T
+	for i, item := range items {
+		r := find(i)
+		p, ok := position[r]
+		if !ok {
+			p = len(groups)
+			position[r] = p
+			groups = append(groups, nil)
+		}
+		groups[p] = append(groups[p], item)
+	}
+	return groups
+}
+
+// formatGroups formats sets as "{{a, b}, {c}}", or "{empty}" if there are none.
+func formatGroups[T any](groups [][]T) string {
+	if len(groups) == 0 {
+		return "{empty}"
+	}
+
+	result := ""
+	for i, group := range groups {
+		if i > 0 {
+			result += ", "
+		}
+		result += "{"
+		for j, item := range group {
+			if j > 0 {
+				result += ", "
+			}
+			result += fmt.Sprintf("%v", item)
+		}
+		result += "}"
+	}
+	return "{" + result + "}"
+}
+
+// unionRecord remembers what a change to a RollbackDisjointSet did, so
+// Rollback can undo it.
+type unionRecord struct {
+	child    int  // root attached by a union, or -1 if an element was added
+	rankedUp bool // true if the union raised the rank of the new root
+}
+
+// RollbackDisjointSet is a DisjointSet whose changes can be undone in the
+// reverse order they were made, as needed for offline dynamic connectivity
+// where edges come and go in a known order. Take a Snapshot, make changes,
+// then Rollback to return to the snapshot.
+//
+// To allow undoing, it does not use path compression, so Find, Union and
+// Connected take O(log n) time instead of nearly constant time.
+type RollbackDisjointSet[T comparable] struct {
+	index   map[T]int
+	items   []T
+	parent  []int
+	rank    []int
+	size    []int
+	count   int
+	history []unionRecord
+}
+
+// NewRollbackDisjointSet creates and returns a new empty disjoint set that
+// supports Snapshot and Rollback.
+func NewRollbackDisjointSet[T comparable]() *RollbackDisjointSet[T] {
+	return &RollbackDisjointSet[T]{index: make(map[T]int)}
+}
+
+// add places a new element in a set of its own and returns its index.
+func (d *RollbackDisjointSet[T]) add(x T) int {
+	i := len(d.items)
+	d.index[x] = i
+	d.items = append(d.items, x)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	d.count++
+	d.history = append(d.history, unionRecord{child: -1})
+	return i
+}
+
+// MakeSet adds an element in a set of its own.
+// Returns false if the element is already in a set.
+func (d *RollbackDisjointSet[T]) MakeSet(x T) bool {
+	if _, ok := d.index[x]; ok {
+		return false
+	}
+	d.add(x)
+	return true
+}
+
+// root returns the root of the tree holding i without changing the tree.
+func (d *RollbackDisjointSet[T]) root(i int) int {
+	for d.parent[i] != i {
+		i = d.parent[i]
+	}
+	return i
+}
+
+// Find returns the representative of the set holding x. Two elements are in
+// the same set exactly when they have the same representative. The
+// representative can change after a Union or Rollback.
+// Returns false if x is not in any set.
+func (d *RollbackDisjointSet[T]) Find(x T) (T, bool) {
+	i, ok := d.index[x]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return d.items[d.root(i)], true
+}
+
+// Union merges the sets holding x and y, first adding either element in a
+// set of its own if it is not in any set.
+// Returns false if x and y were already in the same set.
+func (d *RollbackDisjointSet[T]) Union(x, y T) bool {
+	i, ok := d.index[x]
+	if !ok {
+		i = d.add(x)
+	}
+	j, ok := d.index[y]
+	if !ok {
+		j = d.add(y)
+	}
+
+	ri, rj := d.root(i), d.root(j)
+	if ri == rj {
+		return false
+	}
+	if d.rank[ri] < d.rank[rj] {
+		ri, rj = rj, ri
+	}
+	d.parent[rj] = ri
+	d.size[ri] += d.size[rj]
+	rankedUp := d.rank[ri] == d.rank[rj]
+	if rankedUp {
+		d.rank[ri]++
+	}
+	d.count--
+	d.history = append(d.history, unionRecord{rj, rankedUp})
+	return true
+}
+
+// Snapshot returns a marker for the current state that can be passed to
+// Rollback.
+func (d *RollbackDisjointSet[T]) Snapshot() int {
+	return len(d.history)
+}
+
+// Rollback undoes every MakeSet and Union that changed the sets since the
+// snapshot was taken, newest first. A snapshot stays valid until the state
+// is rolled back past it; after that it must not be used, even if it is
+// still accepted.
+// Returns false if the snapshot is newer than the current state.
+func (d *RollbackDisjointSet[T]) Rollback(snapshot int) bool {
+	if snapshot < 0 || snapshot > len(d.history) {
+		return false
+	}
+
+	for len(d.history) > snapshot {
+		record := d.history[len(d.history)-1]
+		d.history = d.history[:len(d.history)-1]
+
+		if record.child < 0 {
+			// Elements are added at the end, so the last one goes first
+			last := len(d.items) - 1
+			delete(d.index, d.items[last])
+			d.items = d.items[:last]
+			d.parent = d.parent[:last]
+			d.rank = d.rank[:last]
+			d.size = d.size[:last]
+			d.count--
+		} else {
+			r := d.parent[record.child]
+			d.parent[record.child] = record.child
+			d.size[r] -= d.size[record.child]
+			if record.rankedUp {
+				d.rank[r]--
+			}
+			d.count++
+		}
+	}
+	return true
+}
+
+// Connected checks if x and y are in the same set.
+// Returns false if either element is not in any set.
+func (d *RollbackDisjointSet[T]) Connected(x, y T) bool {
+	i, ok := d.index[x]
+	j, ok2 := d.index[y]
+	return ok && ok2 && d.root(i) == d.root(j)
+}
+
+// SetSize returns the number of elements in the set holding x.
+// Returns 0 if x is not in any set.
+func (d *RollbackDisjointSet[T]) SetSize(x T) int {
+	i, ok := d.index[x]
+	if !ok {
+		return 0
+	}
+	return d.size[d.root(i)]
+}
+
+// Count returns the number of sets.
+func (d *RollbackDisjointSet[T]) Count() int {
+	return d.count
+}
+
+// Len returns the number of elements in all sets.
+func (d *RollbackDisjointSet[T]) Len() int {
+	return len(d.items)
+}
+
+// IsEmpty returns true if there are no elements.
+func (d *RollbackDisjointSet[T]) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+// Clear removes all elements, sets and history. Snapshots taken before
+// Clear must not be used.
+func (d *RollbackDisjointSet[T]) Clear() {
+	d.index = make(map[T]int)
+	d.items = nil
+	d.parent = nil
+	d.rank = nil
+	d.size = nil
+	d.count = 0
+	d.history = nil
+}
+
+// Groups returns the elements of every set. Sets are ordered by their
+// first-added element, and the elements of each set are in the order they
+// were added.
+func (d *RollbackDisjointSet[T]) Groups() [][]T {
+	return groupItems(d.items, d.root)
+}
+
+// String returns a string representation of the sets in the order of
+// Groups, like "RollbackDisjointSet{{a, b}, {c}}".
+func (d *RollbackDisjointSet[T]) String() string {
+	return "RollbackDisjointSet" + formatGroups(d.Groups())
+}
@@ -0,0 +1,266 @@
+package collections
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	d := NewDisjointSet[string]()
+	if !d.IsEmpty() || d.Count() != 0 || d.Groups() != nil {
+		t.Error("New disjoint set should be empty")
+	}
+	if str := d.String(); str != "DisjointSet{empty}" {
+		t.Errorf("Expected 'DisjointSet{empty}', got %q", str)
+	}
+
+	if !d.MakeSet("a") || d.MakeSet("a") {
+		t.Error("MakeSet should succeed once")
+	}
+	if rep, ok := d.Find("a"); !ok || rep != "a" {
+		t.Errorf("A new element should represent itself, got %q", rep)
+	}
+	if _, ok := d.Find("x"); ok {
+		t.Error("Find of a missing element should return false")
+	}
+
+	// Union adds missing elements
+	if !d.Union("a", "b") || !d.Union("c", "d") || !d.Union("b", "d") {
+		t.Error("Union of different sets should return true")
+	}
+	d.MakeSet("e")
+	if d.Union("a", "c") || d.Union("e", "e") {
+		t.Error("Union within a set should return false")
+	}
+
+	if d.Len() != 5 || d.Count() != 2 {
+		t.Errorf("Expected 5 elements in 2 sets, got %d in %d", d.Len(), d.Count())
+	}
+	if !d.Connected("a", "d") || d.Connected("a", "e") || d.Connected("a", "x") {
+		t.Error("Unexpected Connected result")
+	}
+	ra, _ := d.Find("a")
+	rd, _ := d.Find("d")
+	if ra != rd {
+		t.Errorf("a and d should share a representative, got %q and %q", ra, rd)
+	}
+	if d.SetSize("c") != 4 || d.SetSize("e") != 1 || d.SetSize("x") != 0 {
+		t.Errorf("Unexpected set sizes %d, %d", d.SetSize("c"), d.SetSize("e"))
+	}
+
+	expected := [][]string{{"a", "b", "c", "d"}, {"e"}}
+	if got := d.Groups(); !slices.EqualFunc(got, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if str := d.String(); str != "DisjointSet{{a, b, c, d}, {e}}" {
+		t.Errorf("Unexpected string %q", str)
+	}
+
+	d.Clear()
+	if !d.IsEmpty() || d.Count() != 0 || d.Connected("a", "a") {
+		t.Error("Disjoint set should be empty after Clear")
+	}
+}
+
+func TestDisjointSetLongChain(t *testing.T) {
+	d := NewDisjointSet[int]()
+	for i := range 100_000 {
+		d.Union(i, i+1)
+	}
+	if d.Count() != 1 || d.SetSize(0) != 100_001 || !d.Connected(0, 100_000) {
+		t.Errorf("Expected one set of 100001, got %d sets", d.Count())
+	}
+	// Union by rank keeps the trees short
+	for i := range d.parent {
+		if d.rank[i] > 17 {
+			t.Fatalf("Rank %d is too high", d.rank[i])
+		}
+	}
+}
+
+func TestRollbackDisjointSet(t *testing.T) {
+	d := NewRollbackDisjointSet[int]()
+	if str := d.String(); str != "RollbackDisjointSet{empty}" {
+		t.Errorf("Expected 'RollbackDisjointSet{empty}', got %q", str)
+	}
+
+	d.Union(1, 2)
+	d.MakeSet(3)
+	base := d.Snapshot()
+
+	d.Union(2, 3)
+	d.Union(4, 5)
+	middle := d.Snapshot()
+	d.Union(1, 5)
+	if d.Count() != 1 || d.SetSize(4) != 5 {
+		t.Errorf("Expected one set of 5, got %d sets", d.Count())
+	}
+	if str := d.String(); str != "RollbackDisjointSet{{1, 2, 3, 4, 5}}" {
+		t.Errorf("Unexpected string %q", str)
+	}
+
+	if !d.Rollback(middle) {
+		t.Fatal("Rollback to a valid snapshot should succeed")
+	}
+	if d.Connected(1, 5) || !d.Connected(4, 5) || d.Count() != 2 || d.SetSize(1) != 3 {
+		t.Errorf("Unexpected state after rollback: %v", d)
+	}
+
+	// Rolling back removes elements added since the snapshot
+	if !d.Rollback(base) {
+		t.Fatal("Rollback to a valid snapshot should succeed")
+	}
+	expected := [][]int{{1, 2}, {3}}
+	if got := d.Groups(); !slices.EqualFunc(got, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if d.Len() != 3 || d.Connected(4, 5) {
+		t.Error("4 and 5 should be gone")
+	}
+	if _, ok := d.Find(4); ok {
+		t.Error("Find of a removed element should return false")
+	}
+
+	if d.Rollback(middle) || d.Rollback(-1) {
+		t.Error("Rollback to a snapshot newer than the state should fail")
+	}
+	if !d.Rollback(d.Snapshot()) || d.Len() != 3 {
+		t.Error("Rollback to the current state should change nothing")
+	}
+
+	// Failed unions and repeated MakeSet record nothing
+	s := d.Snapshot()
+	d.Union(1, 2)
+	d.MakeSet(3)
+	if d.Snapshot() != s {
+		t.Error("No-op changes should not be recorded")
+	}
+
+	d.Clear()
+	if !d.IsEmpty() || d.Snapshot() != 0 {
+		t.Error("Clear should drop the elements and history")
+	}
+}
+
+// labels is a naive disjoint set model: each element maps to a set label.
+type labels map[int]int
+
+func (l labels) union(x, y int) {
+	if _, ok := l[x]; !ok {
+		l[x] = x
+	}
+	if _, ok := l[y]; !ok {
+		l[y] = y
+	}
+	from, to := l[y], l[x]
+	for k, v := range l {
+		if v == from {
+			l[k] = to
+		}
+	}
+}
+
+func (l labels) count() int {
+	seen := make(map[int]bool)
+	for _, v := range l {
+		seen[v] = true
+	}
+	return len(seen)
+}
+
+func (l labels) size(x int) int {
+	n := 0
+	for _, v := range l {
+		if v == l[x] {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDisjointSetRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	d := NewDisjointSet[int]()
+	rd := NewRollbackDisjointSet[int]()
+	model := labels{}
+	type saved struct {
+		snapshot int
+		model    labels
+	}
+	var snapshots []saved
+
+	for step := range 3000 {
+		x, y := r.IntN(60), r.IntN(60)
+		switch op := r.IntN(10); {
+		case op < 6:
+			_, xok := model[x]
+			_, yok := model[y]
+			expected := !xok || !yok || model[x] != model[y]
+			if x == y && !xok {
+				expected = false
+			}
+			model.union(x, y)
+			if got := d.Union(x, y); got != expected {
+				t.Fatalf("Step %d: Union(%d, %d) = %v, expected %v", step, x, y, got, expected)
+			}
+			if got := rd.Union(x, y); got != expected {
+				t.Fatalf("Step %d: rollback Union(%d, %d) = %v, expected %v", step, x, y, got, expected)
+			}
+		case op < 7:
+			_, exists := model[x]
+			if !exists {
+				model[x] = x
+			}
+			if d.MakeSet(x) == exists || rd.MakeSet(x) == exists {
+				t.Fatalf("Step %d: MakeSet(%d) should return %v", step, x, !exists)
+			}
+		case op < 8:
+			snapshots = append(snapshots, saved{rd.Snapshot(), maps.Clone(model)})
+		case len(snapshots) > 0:
+			// Roll back to a random earlier snapshot, dropping the newer ones
+			k := r.IntN(len(snapshots))
+			s := snapshots[k]
+			snapshots = snapshots[:k]
+			if !rd.Rollback(s.snapshot) {
+				t.Fatalf("Step %d: Rollback(%d) failed", step, s.snapshot)
+			}
+			model = s.model
+			// Rebuild the plain set to match
+			d.Clear()
+			for k, v := range model {
+				d.Union(k, v)
+			}
+		}
+
+		for name, set := range map[string]interface {
+			Count() int
+			Len() int
+			Connected(x, y int) bool
+			SetSize(x int) int
+		}{"DisjointSet": d, "RollbackDisjointSet": rd} {
+			if set.Len() != len(model) || set.Count() != model.count() {
+				t.Fatalf("Step %d: %s has %d elements in %d sets, expected %d in %d",
+					step, name, set.Len(), set.Count(), len(model), model.count())
+			}
+			_, xok := model[x]
+			_, yok := model[y]
+			if got := set.Connected(x, y); got != (xok && yok && model[x] == model[y]) {
+				t.Fatalf("Step %d: %s Connected(%d, %d) = %v", step, name, x, y, got)
+			}
+			if xok && set.SetSize(x) != model.size(x) {
+				t.Fatalf("Step %d: %s SetSize(%d) = %d, expected %d", step, name, x, set.SetSize(x), model.size(x))
+			}
+		}
+	}
+
+	// Every group must hold exactly the elements with one label
+	for _, group := range rd.Groups() {
+		for _, v := range group {
+			if model[v] != model[group[0]] {
+				t.Fatalf("Group %v mixes sets", group)
+			}
+		}
+	}
+}
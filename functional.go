@@ -0,0 +1,296 @@
+package collections
+
+// Pair holds two values of possibly different types.
+// It is the element type produced by the Zip functions.
+type Pair[T, U any] struct {
+	// First holds the value taken from the first collection.
+	First T
+
+	// Second holds the value taken from the second collection.
+	Second U
+}
+
+// Map returns a new slice with fn applied to each value.
+// Use it with the slices returned by the Tree traversal methods.
+func Map[T, U any](values []T, fn func(T) U) []U {
+	result := make([]U, 0, len(values))
+	for _, value := range values {
+		result = append(result, fn(value))
+	}
+	return result
+}
+
+// Filter returns a new slice containing only the values that match the predicate.
+func Filter[T any](values []T, fn func(T) bool) []T {
+	result := []T{}
+	for _, value := range values {
+		if fn(value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// Reduce combines all values into a single result, starting from initial.
+func Reduce[T, A any](values []T, initial A, fn func(A, T) A) A {
+	acc := initial
+	for _, value := range values {
+		acc = fn(acc, value)
+	}
+	return acc
+}
+
+// Partition splits the values into those that match the predicate and those that don't.
+func Partition[T any](values []T, fn func(T) bool) ([]T, []T) {
+	matched, rest := []T{}, []T{}
+	for _, value := range values {
+		if fn(value) {
+			matched = append(matched, value)
+		} else {
+			rest = append(rest, value)
+		}
+	}
+	return matched, rest
+}
+
+// GroupBy groups the values by the key returned by fn, keeping their order.
+func GroupBy[K comparable, T any](values []T, fn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, value := range values {
+		key := fn(value)
+		groups[key] = append(groups[key], value)
+	}
+	return groups
+}
+
+// Zip pairs up the values of a and b by position.
+// The result is as long as the shorter of the two slices.
+func Zip[T, U any](a []T, b []U) []Pair[T, U] {
+	n := min(len(a), len(b))
+	result := make([]Pair[T, U], n)
+	for i := range n {
+		result[i] = Pair[T, U]{First: a[i], Second: b[i]}
+	}
+	return result
+}
+
+// Chunk splits the values into consecutive slices of at most size elements.
+// Returns nil if size is not positive.
+func Chunk[T any](values []T, size int) [][]T {
+	if size <= 0 {
+		return nil
+	}
+	chunks := [][]T{}
+	for start := 0; start < len(values); start += size {
+		end := min(start+size, len(values))
+		chunk := make([]T, end-start)
+		copy(chunk, values[start:end])
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// Distinct returns the values with duplicates removed, keeping the first occurrence.
+func Distinct[T comparable](values []T) []T {
+	seen := make(map[T]struct{})
+	result := []T{}
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	return result
+}
+
+// MapList returns a new list with fn applied to each value.
+// The new list is circular if the original list is circular.
+func MapList[T, U any](l *LinkedList[T], fn func(T) U) *LinkedList[U] {
+	result := &LinkedList[U]{circular: l.circular}
+	l.ForEach(func(value T) {
+		result.Append(fn(value))
+	})
+	return result
+}
+
+// FilterList returns a new list containing only the values that match the predicate.
+// The new list is circular if the original list is circular.
+func FilterList[T any](l *LinkedList[T], fn func(T) bool) *LinkedList[T] {
+	result := &LinkedList[T]{circular: l.circular}
+	l.ForEach(func(value T) {
+		if fn(value) {
+			result.Append(value)
+		}
+	})
+	return result
+}
+
+// ReduceList combines all values of the list into a single result, starting from initial.
+func ReduceList[T, A any](l *LinkedList[T], initial A, fn func(A, T) A) A {
+	acc := initial
+	l.ForEach(func(value T) {
+		acc = fn(acc, value)
+	})
+	return acc
+}
+
+// PartitionList splits the list into two new lists: the values that match the
+// predicate and those that don't. Both lists keep the original circularity.
+func PartitionList[T any](l *LinkedList[T], fn func(T) bool) (*LinkedList[T], *LinkedList[T]) {
+	matched := &LinkedList[T]{circular: l.circular}
+	rest := &LinkedList[T]{circular: l.circular}
+	l.ForEach(func(value T) {
+		if fn(value) {
+			matched.Append(value)
+		} else {
+			rest.Append(value)
+		}
+	})
+	return matched, rest
+}
+
+// GroupByList groups the values of the list by the key returned by fn.
+// Each group is a new list that keeps the original circularity.
+func GroupByList[K comparable, T any](l *LinkedList[T], fn func(T) K) map[K]*LinkedList[T] {
+	groups := make(map[K]*LinkedList[T])
+	l.ForEach(func(value T) {
+		key := fn(value)
+		group, ok := groups[key]
+		if !ok {
+			group = &LinkedList[T]{circular: l.circular}
+			groups[key] = group
+		}
+		group.Append(value)
+	})
+	return groups
+}
+
+// ZipList pairs up the values of a and b by position into a new list.
+// The result is as long as the shorter list and is circular if a is circular.
+func ZipList[T, U any](a *LinkedList[T], b *LinkedList[U]) *LinkedList[Pair[T, U]] {
+	result := &LinkedList[Pair[T, U]]{circular: a.circular}
+	left, right := a.head, b.head
+	for i := 0; i < min(a.size, b.size); i++ {
+		result.Append(Pair[T, U]{First: left.Value, Second: right.Value})
+		left = left.Next
+		right = right.Next
+	}
+	return result
+}
+
+// ChunkList splits the list into new lists of at most size elements.
+// Each chunk keeps the original circularity. Returns nil if size is not positive.
+func ChunkList[T any](l *LinkedList[T], size int) []*LinkedList[T] {
+	if size <= 0 {
+		return nil
+	}
+	chunks := []*LinkedList[T]{}
+	var current *LinkedList[T]
+	l.ForEach(func(value T) {
+		if current == nil || current.size == size {
+			current = &LinkedList[T]{circular: l.circular}
+			chunks = append(chunks, current)
+		}
+		current.Append(value)
+	})
+	return chunks
+}
+
+// DistinctList returns a new list with duplicates removed, keeping the first occurrence.
+// The new list is circular if the original list is circular.
+func DistinctList[T comparable](l *LinkedList[T]) *LinkedList[T] {
+	seen := make(map[T]struct{})
+	result := &LinkedList[T]{circular: l.circular}
+	l.ForEach(func(value T) {
+		if _, ok := seen[value]; ok {
+			return
+		}
+		seen[value] = struct{}{}
+		result.Append(value)
+	})
+	return result
+}
+
+// MapQueue returns a new queue with fn applied to each element.
+// The new queue has the same capacity as the original queue.
+func MapQueue[T, U any](q *Queue[T], fn func(T) U) *Queue[U] {
+	result := &Queue[U]{capacity: q.capacity}
+	for _, element := range q.elements {
+		result.Enqueue(fn(element))
+	}
+	return result
+}
+
+// FilterQueue returns a new queue containing only elements that match the predicate.
+// It is equivalent to Queue.Filter.
+func FilterQueue[T any](q *Queue[T], fn func(T) bool) *Queue[T] {
+	return q.Filter(fn)
+}
+
+// ReduceQueue combines all elements of the queue into a single result, starting from initial.
+func ReduceQueue[T, A any](q *Queue[T], initial A, fn func(A, T) A) A {
+	return Reduce(q.elements, initial, fn)
+}
+
+// PartitionQueue splits the queue into two new queues: the elements that match
+// the predicate and those that don't. Both queues keep the original capacity.
+func PartitionQueue[T any](q *Queue[T], fn func(T) bool) (*Queue[T], *Queue[T]) {
+	matched := &Queue[T]{capacity: q.capacity}
+	rest := &Queue[T]{capacity: q.capacity}
+	for _, element := range q.elements {
+		if fn(element) {
+			matched.Enqueue(element)
+		} else {
+			rest.Enqueue(element)
+		}
+	}
+	return matched, rest
+}
+
+// GroupByQueue groups the elements of the queue by the key returned by fn.
+// Each group is a new queue that keeps the original capacity.
+func GroupByQueue[K comparable, T any](q *Queue[T], fn func(T) K) map[K]*Queue[T] {
+	groups := make(map[K]*Queue[T])
+	for _, element := range q.elements {
+		key := fn(element)
+		group, ok := groups[key]
+		if !ok {
+			group = &Queue[T]{capacity: q.capacity}
+			groups[key] = group
+		}
+		group.Enqueue(element)
+	}
+	return groups
+}
+
+// ZipQueue pairs up the elements of a and b by position into a new queue.
+// The result is as long as the shorter queue and has the capacity of a.
+func ZipQueue[T, U any](a *Queue[T], b *Queue[U]) *Queue[Pair[T, U]] {
+	result := &Queue[Pair[T, U]]{capacity: a.capacity}
+	result.EnqueueAll(Zip(a.elements, b.elements))
+	return result
+}
+
+// ChunkQueue splits the queue into new queues of at most size elements.
+// Each chunk keeps the original capacity. Returns nil if size is not positive.
+func ChunkQueue[T any](q *Queue[T], size int) []*Queue[T] {
+	if size <= 0 {
+		return nil
+	}
+	chunks := []*Queue[T]{}
+	for _, chunk := range Chunk(q.elements, size) {
+		newQueue := &Queue[T]{capacity: q.capacity}
+		newQueue.EnqueueAll(chunk)
+		chunks = append(chunks, newQueue)
+	}
+	return chunks
+}
+
+// DistinctQueue returns a new queue with duplicates removed, keeping the first occurrence.
+// The new queue has the same capacity as the original queue.
+func DistinctQueue[T comparable](q *Queue[T]) *Queue[T] {
+	result := &Queue[T]{capacity: q.capacity}
+	result.EnqueueAll(Distinct(q.elements))
+	return result
+}
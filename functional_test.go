@@ -0,0 +1,221 @@
+package collections
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tree := NewTree[int]()
+	tree.Insert(1)
+	tree.Insert(2)
+	tree.Insert(3)
+
+	result := Map(tree.LevelOrder(), strconv.Itoa)
+	expected := []string{"1", "2", "3"}
+
+	if len(result) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(result))
+	}
+	for i, val := range expected {
+		if result[i] != val {
+			t.Errorf("Expected result[%d] = %s, got %s", i, val, result[i])
+		}
+	}
+}
+
+func TestSliceFilterReducePartition(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6}
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	evens := Filter(values, isEven)
+	if len(evens) != 3 {
+		t.Errorf("Expected 3 even values, got %d", len(evens))
+	}
+
+	sum := Reduce(values, 0, func(acc, v int) int { return acc + v })
+	if sum != 21 {
+		t.Errorf("Expected sum 21, got %d", sum)
+	}
+
+	matched, rest := Partition(values, isEven)
+	if len(matched) != 3 || len(rest) != 3 {
+		t.Errorf("Expected 3 and 3 values, got %d and %d", len(matched), len(rest))
+	}
+	if rest[0] != 1 {
+		t.Errorf("Expected rest[0] = 1, got %d", rest[0])
+	}
+}
+
+func TestSliceGroupByZipChunkDistinct(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+
+	groups := GroupBy(values, func(v int) bool { return v%2 == 0 })
+	if len(groups[true]) != 2 || len(groups[false]) != 3 {
+		t.Errorf("Expected groups of 2 and 3, got %d and %d", len(groups[true]), len(groups[false]))
+	}
+
+	pairs := Zip(values, []string{"a", "b"})
+	if len(pairs) != 2 {
+		t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+	}
+	if pairs[1].First != 2 || pairs[1].Second != "b" {
+		t.Errorf("Expected pair {2 b}, got %v", pairs[1])
+	}
+
+	chunks := Chunk(values, 2)
+	if len(chunks) != 3 {
+		t.Fatalf("Expected 3 chunks, got %d", len(chunks))
+	}
+	if len(chunks[2]) != 1 || chunks[2][0] != 5 {
+		t.Errorf("Expected last chunk [5], got %v", chunks[2])
+	}
+	if Chunk(values, 0) != nil {
+		t.Error("Chunk with size 0 should return nil")
+	}
+
+	distinct := Distinct([]int{3, 1, 3, 2, 1})
+	expected := []int{3, 1, 2}
+	if len(distinct) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(distinct))
+	}
+	for i, val := range expected {
+		if distinct[i] != val {
+			t.Errorf("Expected distinct[%d] = %d, got %d", i, val, distinct[i])
+		}
+	}
+}
+
+func TestMapList(t *testing.T) {
+	list := NewCircularLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+
+	result := MapList(list, func(v int) int { return v * 10 })
+
+	if !result.IsCircular() {
+		t.Error("Mapping a circular list should yield a circular list")
+	}
+	if err := result.Validate(); err != nil {
+		t.Errorf("Mapped list should be valid, got %v", err)
+	}
+
+	expected := []int{10, 20, 30}
+	slice := result.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+}
+
+func TestListTransforms(t *testing.T) {
+	list := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 2, 3, 4, 4} {
+		list.Append(v)
+	}
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	if evens := FilterList(list, isEven); evens.Len() != 4 {
+		t.Errorf("Expected 4 even values, got %d", evens.Len())
+	}
+
+	if sum := ReduceList(list, 0, func(acc, v int) int { return acc + v }); sum != 16 {
+		t.Errorf("Expected sum 16, got %d", sum)
+	}
+
+	matched, rest := PartitionList(list, isEven)
+	if matched.Len() != 4 || rest.Len() != 2 {
+		t.Errorf("Expected 4 and 2 values, got %d and %d", matched.Len(), rest.Len())
+	}
+
+	groups := GroupByList(list, isEven)
+	if groups[false].Len() != 2 {
+		t.Errorf("Expected 2 odd values, got %d", groups[false].Len())
+	}
+
+	other := NewLinkedList[string]()
+	other.Append("a")
+	other.Append("b")
+	pairs := ZipList(list, other)
+	if pairs.Len() != 2 {
+		t.Errorf("Expected 2 pairs, got %d", pairs.Len())
+	}
+	if last, _ := pairs.GetLast(); last.First != 2 || last.Second != "b" {
+		t.Errorf("Expected last pair {2 b}, got %v", last)
+	}
+
+	chunks := ChunkList(list, 4)
+	if len(chunks) != 2 || chunks[0].Len() != 4 || chunks[1].Len() != 2 {
+		t.Errorf("Expected chunks of 4 and 2, got %v", chunks)
+	}
+
+	distinct := DistinctList(list)
+	expected := []int{1, 2, 3, 4}
+	slice := distinct.ToSlice()
+	if len(slice) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(slice))
+	}
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+}
+
+func TestMapQueue(t *testing.T) {
+	q := NewBoundedQueue[int](5)
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	result := MapQueue(q, func(v int) string { return strconv.Itoa(v * 2) })
+
+	if result.capacity != 5 {
+		t.Errorf("Expected capacity 5, got %d", result.capacity)
+	}
+	if first, _ := result.Peek(); first != "2" {
+		t.Errorf("Expected first element '2', got '%s'", first)
+	}
+}
+
+func TestQueueTransforms(t *testing.T) {
+	q := NewBoundedQueue[int](10)
+	q.EnqueueAll([]int{1, 2, 2, 3, 4})
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	if evens := FilterQueue(q, isEven); evens.Len() != 3 {
+		t.Errorf("Expected 3 even values, got %d", evens.Len())
+	}
+
+	if sum := ReduceQueue(q, 0, func(acc, v int) int { return acc + v }); sum != 12 {
+		t.Errorf("Expected sum 12, got %d", sum)
+	}
+
+	matched, rest := PartitionQueue(q, isEven)
+	if matched.Len() != 3 || rest.Len() != 2 {
+		t.Errorf("Expected 3 and 2 values, got %d and %d", matched.Len(), rest.Len())
+	}
+	if rest.capacity != 10 {
+		t.Errorf("Expected capacity 10, got %d", rest.capacity)
+	}
+
+	groups := GroupByQueue(q, isEven)
+	if groups[true].Len() != 3 {
+		t.Errorf("Expected 3 even values, got %d", groups[true].Len())
+	}
+
+	pairs := ZipQueue(q, q)
+	if pairs.Len() != 5 {
+		t.Errorf("Expected 5 pairs, got %d", pairs.Len())
+	}
+
+	chunks := ChunkQueue(q, 2)
+	if len(chunks) != 3 {
+		t.Errorf("Expected 3 chunks, got %d", len(chunks))
+	}
+
+	if distinct := DistinctQueue(q); distinct.Len() != 4 {
+		t.Errorf("Expected 4 distinct values, got %d", distinct.Len())
+	}
+}
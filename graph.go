@@ -0,0 +1,332 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrVertexNotFound is returned when an algorithm starts from a vertex that
+// is not in the graph.
+var ErrVertexNotFound = errors.New("collections: vertex not found")
+
+// Number is the set of types that can be used as edge weights.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Edge is an edge of a Graph, as returned by Edges.
+type Edge[K comparable, W Number] struct {
+	// From is the vertex the edge starts at.
+	From K
+
+	// To is the vertex the edge ends at.
+	To K
+
+	// Weight is the weight of the edge.
+	Weight W
+}
+
+type graphEdge[W Number] struct {
+	to     int
+	weight W
+}
+
+// Graph represents a weighted graph with vertices of type K and edge weights
+// of type W. It can be directed or undirected. Vertices and edges are kept in
+// the order they were added, so every traversal visits them in a predictable
+// order. Use a weight of 1 for graphs that are not weighted.
+//
+// An undirected edge is stored once in each direction. Parallel edges are not
+// supported: adding an edge that exists replaces its weight.
+type Graph[K comparable, W Number] struct {
+	directed bool
+	index    map[K]int
+	keys     []K
+	adj      [][]graphEdge[W] // adj[i] holds the edges out of keys[i]
+	edges    int
+}
+
+// NewGraph creates and returns a new empty undirected graph.
+func NewGraph[K comparable, W Number]() *Graph[K, W] {
+	return &Graph[K, W]{index: make(map[K]int)}
+}
+
+// NewDirectedGraph creates and returns a new empty directed graph.
+func NewDirectedGraph[K comparable, W Number]() *Graph[K, W] {
+	return &Graph[K, W]{directed: true, index: make(map[K]int)}
+}
+
+// IsDirected returns true if the edges of the graph have a direction.
+func (g *Graph[K, W]) IsDirected() bool {
+	return g.directed
+}
+
+// vertex returns the index of a vertex, adding it if needed.
+func (g *Graph[K, W]) vertex(key K) int {
+	if i, ok := g.index[key]; ok {
+		return i
+	}
+	i := len(g.keys)
+	g.index[key] = i
+	g.keys = append(g.keys, key)
+	g.adj = append(g.adj, nil)
+	return i
+}
+
+// AddVertex adds a vertex with no edges.
+// Returns false if the vertex is already in the graph.
+func (g *Graph[K, W]) AddVertex(key K) bool {
+	if _, ok := g.index[key]; ok {
+		return false
+	}
+	g.vertex(key)
+	return true
+}
+
+// HasVertex checks if the vertex is in the graph.
+func (g *Graph[K, W]) HasVertex(key K) bool {
+	_, ok := g.index[key]
+	return ok
+}
+
+// setEdge adds or updates the edge from i to j. Returns true if it was added.
+func (g *Graph[K, W]) setEdge(i, j int, weight W) bool {
+	for k := range g.adj[i] {
+		if g.adj[i][k].to == j {
+			g.adj[i][k].weight = weight
+			return false
+		}
+	}
+	g.adj[i] = append(g.adj[i], graphEdge[W]{j, weight})
+	return true
+}
+
+// deleteEdge removes the edge from i to j. Returns false if there is none.
+func (g *Graph[K, W]) deleteEdge(i, j int) bool {
+	for k := range g.adj[i] {
+		if g.adj[i][k].to == j {
+			g.adj[i] = slices.Delete(g.adj[i], k, k+1)
+			return true
+		}
+	}
+	return false
+}
+
+// AddEdge adds an edge from one vertex to another with the given weight,
+// adding the vertices if they are not in the graph. In an undirected graph
+// the edge goes both ways. If the edge exists, its weight is replaced.
+// Returns true if a new edge was added.
+func (g *Graph[K, W]) AddEdge(from, to K, weight W) bool {
+	i, j := g.vertex(from), g.vertex(to)
+	added := g.setEdge(i, j, weight)
+	if !g.directed && i != j {
+		g.setEdge(j, i, weight)
+	}
+	if added {
+		g.edges++
+	}
+	return added
+}
+
+// RemoveEdge removes the edge from one vertex to another. In an undirected
+// graph the edge is removed in both directions. The vertices are kept.
+// Returns false if there is no such edge.
+func (g *Graph[K, W]) RemoveEdge(from, to K) bool {
+	i, ok := g.index[from]
+	j, ok2 := g.index[to]
+	if !ok || !ok2 || !g.deleteEdge(i, j) {
+		return false
+	}
+	if !g.directed && i != j {
+		g.deleteEdge(j, i)
+	}
+	g.edges--
+	return true
+}
+
+// HasEdge checks if there is an edge from one vertex to another.
+func (g *Graph[K, W]) HasEdge(from, to K) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from one vertex to another.
+// Returns false if there is no such edge.
+func (g *Graph[K, W]) Weight(from, to K) (W, bool) {
+	i, ok := g.index[from]
+	j, ok2 := g.index[to]
+	if ok && ok2 {
+		for _, e := range g.adj[i] {
+			if e.to == j {
+				return e.weight, true
+			}
+		}
+	}
+	var zero W
+	return zero, false
+}
+
+// Neighbors returns the vertices that the edges out of a vertex lead to, in
+// the order the edges were added. Returns nil if the vertex is not in the graph.
+func (g *Graph[K, W]) Neighbors(key K) []K {
+	i, ok := g.index[key]
+	if !ok {
+		return nil
+	}
+	neighbors := make([]K, len(g.adj[i]))
+	for k, e := range g.adj[i] {
+		neighbors[k] = g.keys[e.to]
+	}
+	return neighbors
+}
+
+// Vertices returns all vertices in the order they were added.
+func (g *Graph[K, W]) Vertices() []K {
+	return append([]K(nil), g.keys...)
+}
+
+// Edges returns all edges, grouped by the vertex they start at. In an
+// undirected graph each edge is listed once, from the vertex added first.
+func (g *Graph[K, W]) Edges() []Edge[K, W] {
+	edges := make([]Edge[K, W], 0, g.edges)
+	for i, out := range g.adj {
+		for _, e := range out {
+			if g.directed || i <= e.to {
+				edges = append(edges, Edge[K, W]{g.keys[i], g.keys[e.to], e.weight})
+			}
+		}
+	}
+	return edges
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g *Graph[K, W]) VertexCount() int {
+	return len(g.keys)
+}
+
+// EdgeCount returns the number of edges in the graph. An undirected edge
+// counts once.
+func (g *Graph[K, W]) EdgeCount() int {
+	return g.edges
+}
+
+// IsEmpty returns true if the graph has no vertices.
+func (g *Graph[K, W]) IsEmpty() bool {
+	return len(g.keys) == 0
+}
+
+// Clear removes all vertices and edges from the graph.
+func (g *Graph[K, W]) Clear() {
+	g.index = make(map[K]int)
+	g.keys = nil
+	g.adj = nil
+	g.edges = 0
+}
+
+// BFS visits the vertices reachable from start in breadth-first order,
+// using a Queue. Neighbors are visited in the order their edges were added.
+// Returning false from fn stops the search. Nothing is visited if start is
+// not in the graph.
+func (g *Graph[K, W]) BFS(start K, fn func(K) bool) {
+	s, ok := g.index[start]
+	if !ok {
+		return
+	}
+
+	visited := make([]bool, len(g.keys))
+	visited[s] = true
+	queue := NewQueue[int]()
+	queue.Enqueue(s)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Dequeue()
+		if !fn(g.keys[current]) {
+			return
+		}
+		for _, e := range g.adj[current] {
+			if !visited[e.to] {
+				visited[e.to] = true
+				queue.Enqueue(e.to)
+			}
+		}
+	}
+}
+
+// DFS visits the vertices reachable from start in depth-first order, the
+// same order as a recursive search that follows edges in the order they were
+// added. It uses an explicit stack, so long paths do not grow the call stack.
+// Returning false from fn stops the search. Nothing is visited if start is
+// not in the graph.
+func (g *Graph[K, W]) DFS(start K, fn func(K) bool) {
+	s, ok := g.index[start]
+	if !ok {
+		return
+	}
+
+	type frame struct {
+		vertex int
+		next   int // index of the next edge to follow
+	}
+
+	visited := make([]bool, len(g.keys))
+	visited[s] = true
+	if !fn(start) {
+		return
+	}
+	stack := []frame{{s, 0}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(g.adj[top.vertex]) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		to := g.adj[top.vertex][top.next].to
+		top.next++
+		if visited[to] {
+			continue
+		}
+		visited[to] = true
+		if !fn(g.keys[to]) {
+			return
+		}
+		stack = append(stack, frame{to, 0})
+	}
+}
+
+// String returns a string representation of the graph with one line per
+// vertex, listing its edges and their weights in the order they were added:
+//
+//	a -> b (1), c (4)
+//	b -> c (2)
+//	c
+//
+// Undirected graphs use "--" instead of "->".
+func (g *Graph[K, W]) String() string {
+	if len(g.keys) == 0 {
+		return "Graph{empty}\n"
+	}
+
+	arrow := " -> "
+	if !g.directed {
+		arrow = " -- "
+	}
+
+	var b strings.Builder
+	for i, key := range g.keys {
+		fmt.Fprintf(&b, "%v", key)
+		for k, e := range g.adj[i] {
+			if k == 0 {
+				b.WriteString(arrow)
+			} else {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%v (%v)", g.keys[e.to], e.weight)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+// collectBFS and collectDFS return the full visiting order from start.
+func collectBFS[K comparable, W Number](g *Graph[K, W], start K) []K {
+	var order []K
+	g.BFS(start, func(k K) bool { order = append(order, k); return true })
+	return order
+}
+
+func collectDFS[K comparable, W Number](g *Graph[K, W], start K) []K {
+	var order []K
+	g.DFS(start, func(k K) bool { order = append(order, k); return true })
+	return order
+}
+
+func TestNewGraph(t *testing.T) {
+	g := NewGraph[string, int]()
+	if g == nil {
+		t.Fatal("NewGraph() returned nil")
+	}
+	if !g.IsEmpty() || g.IsDirected() || g.VertexCount() != 0 || g.EdgeCount() != 0 {
+		t.Error("New graph should be empty and undirected")
+	}
+	if str := g.String(); str != "Graph{empty}\n" {
+		t.Errorf("Expected 'Graph{empty}', got %q", str)
+	}
+	if !NewDirectedGraph[int, float64]().IsDirected() {
+		t.Error("NewDirectedGraph should be directed")
+	}
+}
+
+func TestGraphEdges(t *testing.T) {
+	g := NewGraph[string, int]()
+	if !g.AddVertex("a") || g.AddVertex("a") {
+		t.Error("AddVertex should succeed once")
+	}
+	if !g.AddEdge("a", "b", 1) || !g.AddEdge("a", "c", 4) || !g.AddEdge("b", "c", 2) {
+		t.Error("AddEdge of new edges should return true")
+	}
+	if g.AddEdge("c", "a", 5) {
+		t.Error("An undirected edge exists in both directions")
+	}
+
+	if g.VertexCount() != 3 || g.EdgeCount() != 3 {
+		t.Errorf("Expected 3 vertices and 3 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+	if w, ok := g.Weight("a", "c"); !ok || w != 5 {
+		t.Errorf("Expected weight 5 both ways, got %d", w)
+	}
+	if !slices.Equal(g.Neighbors("c"), []string{"a", "b"}) || g.Neighbors("x") != nil {
+		t.Errorf("Unexpected neighbors %v", g.Neighbors("c"))
+	}
+	if !slices.Equal(g.Vertices(), []string{"a", "b", "c"}) {
+		t.Errorf("Unexpected vertices %v", g.Vertices())
+	}
+
+	expected := []Edge[string, int]{{"a", "b", 1}, {"a", "c", 5}, {"b", "c", 2}}
+	if !slices.Equal(g.Edges(), expected) {
+		t.Errorf("Expected %v, got %v", expected, g.Edges())
+	}
+	if str := g.String(); str != "a -- b (1), c (5)\nb -- a (1), c (2)\nc -- a (5), b (2)\n" {
+		t.Errorf("Unexpected string:\n%s", str)
+	}
+
+	if !g.RemoveEdge("c", "a") || g.RemoveEdge("a", "c") || g.RemoveEdge("a", "x") {
+		t.Error("RemoveEdge should succeed once")
+	}
+	if g.HasEdge("a", "c") || g.HasEdge("c", "a") || !g.HasVertex("c") || g.EdgeCount() != 2 {
+		t.Error("RemoveEdge should remove both directions and keep the vertices")
+	}
+
+	// Self-loops are stored once
+	g.AddEdge("d", "d", 7)
+	if !slices.Equal(g.Neighbors("d"), []string{"d"}) || g.EdgeCount() != 3 {
+		t.Errorf("Unexpected self-loop %v", g.Neighbors("d"))
+	}
+	g.RemoveEdge("d", "d")
+
+	g.Clear()
+	if !g.IsEmpty() || g.EdgeCount() != 0 || g.HasVertex("a") {
+		t.Error("Graph should be empty after Clear")
+	}
+}
+
+func TestDirectedGraphEdges(t *testing.T) {
+	g := NewDirectedGraph[int, float64]()
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(2, 1, 1.5)
+	g.AddEdge(1, 3, 2)
+
+	if w, _ := g.Weight(2, 1); w != 1.5 {
+		t.Errorf("Directed edges should keep their own weights, got %v", w)
+	}
+	if g.HasEdge(3, 1) {
+		t.Error("A directed edge goes one way")
+	}
+	if g.EdgeCount() != 3 || len(g.Edges()) != 3 {
+		t.Errorf("Expected 3 edges, got %v", g.Edges())
+	}
+	if str := g.String(); str != "1 -> 2 (0.5), 3 (2)\n2 -> 1 (1.5)\n3\n" {
+		t.Errorf("Unexpected string:\n%s", str)
+	}
+}
+
+func TestGraphTraversal(t *testing.T) {
+	//   a - b - d
+	//   |   |
+	//   c - e   f
+	g := NewGraph[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("b", "e", 1)
+	g.AddEdge("c", "e", 1)
+	g.AddVertex("f")
+
+	if got := collectBFS(g, "a"); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Unexpected BFS order %v", got)
+	}
+	if got := collectDFS(g, "a"); !slices.Equal(got, []string{"a", "b", "d", "e", "c"}) {
+		t.Errorf("Unexpected DFS order %v", got)
+	}
+	if got := collectBFS(g, "f"); !slices.Equal(got, []string{"f"}) {
+		t.Errorf("Expected only f, got %v", got)
+	}
+	if collectBFS(g, "x") != nil || collectDFS(g, "x") != nil {
+		t.Error("A missing start vertex should visit nothing")
+	}
+
+	// Returning false stops the search
+	for name, search := range map[string]func(string, func(string) bool){"BFS": g.BFS, "DFS": g.DFS} {
+		count := 0
+		search("a", func(string) bool { count++; return count < 2 })
+		if count != 2 {
+			t.Errorf("%s should stop after 2 vertices, visited %d", name, count)
+		}
+	}
+
+	// A long path does not grow the call stack
+	long := NewDirectedGraph[int, int]()
+	for i := range 100_000 {
+		long.AddEdge(i, i+1, 1)
+	}
+	if got := collectDFS(long, 0); len(got) != 100_001 || got[100_000] != 100_000 {
+		t.Errorf("Expected all 100001 vertices, got %d", len(got))
+	}
+}
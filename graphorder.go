@@ -0,0 +1,273 @@
+package collections
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrCycle is wrapped by the CycleError returned from TopologicalSort.
+var ErrCycle = errors.New("collections: graph has a cycle")
+
+// CycleError is returned by TopologicalSort when the graph has a cycle.
+// It wraps ErrCycle, so errors.Is(err, ErrCycle) reports true.
+type CycleError[K comparable] struct {
+	// Cycle lists the vertices of one cycle in edge order, starting at the
+	// vertex added to the graph first. The last vertex has an edge back to
+	// the first one.
+	Cycle []K
+}
+
+// Error returns the cycle as "collections: graph has a cycle: a -> b -> a".
+func (e *CycleError[K]) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrCycle.Error() + ": ")
+	for _, key := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", key)
+	}
+	fmt.Fprintf(&b, "%v", e.Cycle[0])
+	return b.String()
+}
+
+// Unwrap returns ErrCycle.
+func (e *CycleError[K]) Unwrap() error {
+	return ErrCycle
+}
+
+// indexHeap is a min-heap of vertex indices for container/heap.
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *indexHeap) Pop() any {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
+
+// TopologicalSort orders the vertices of a directed graph so every edge goes
+// from an earlier vertex to a later one, as needed to resolve dependencies.
+// It uses Kahn's algorithm with a min-heap: among the vertices that are
+// ready, the one added first comes first, so vertices a, c, b with an edge
+// a -> c sort as [a c b]. It takes O((V + E) log V) time. If the graph has
+// a cycle, it returns a *CycleError naming the vertices of one cycle.
+func (g *Graph[K, W]) TopologicalSort() ([]K, error) {
+	if !g.directed {
+		return nil, errors.New("collections: TopologicalSort needs a directed graph")
+	}
+
+	n := len(g.keys)
+	inDegree := make([]int, n)
+	for _, out := range g.adj {
+		for _, e := range out {
+			inDegree[e.to]++
+		}
+	}
+
+	// Indices only grow, so the smallest ready index is the first-added vertex
+	ready := &indexHeap{}
+	for i, d := range inDegree {
+		if d == 0 {
+			*ready = append(*ready, i)
+		}
+	}
+
+	order := make([]K, 0, n)
+	for ready.Len() > 0 {
+		current := heap.Pop(ready).(int)
+		order = append(order, g.keys[current])
+		for _, e := range g.adj[current] {
+			inDegree[e.to]--
+			if inDegree[e.to] == 0 {
+				heap.Push(ready, e.to)
+			}
+		}
+	}
+
+	if len(order) < n {
+		return nil, &CycleError[K]{Cycle: g.findCycle(inDegree)}
+	}
+	return order, nil
+}
+
+// findCycle returns a cycle among the vertices that Kahn's algorithm could
+// not order, which are those with a positive remaining in-degree. Each of
+// them has a predecessor among the others, so walking back from any of them
+// must come round to a vertex already seen.
+func (g *Graph[K, W]) findCycle(inDegree []int) []K {
+	pred := make([]int, len(g.keys))
+	start := -1
+	for i, out := range g.adj {
+		if inDegree[i] == 0 {
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		for _, e := range out {
+			if inDegree[e.to] > 0 {
+				pred[e.to] = i
+			}
+		}
+	}
+
+	position := make(map[int]int)
+	path := []int{}
+	v := start
+	for {
+		if _, seen := position[v]; seen {
+			break
+		}
+		position[v] = len(path)
+		path = append(path, v)
+		v = pred[v]
+	}
+
+	// The walk followed edges backwards; turn the cycle around and start it
+	// at its first-added vertex
+	cycle := path[position[v]:]
+	slices.Reverse(cycle)
+	first := slices.Index(cycle, slices.Min(cycle))
+	cycle = append(cycle[first:], cycle[:first]...)
+
+	keys := make([]K, len(cycle))
+	for i, c := range cycle {
+		keys[i] = g.keys[c]
+	}
+	return keys
+}
+
+// ConnectedComponents splits the vertices into groups that are connected by
+// edges, ignoring their direction, so a directed graph gives its weakly
+// connected components. Groups are ordered by their first-added vertex, and
+// the vertices of each group are in the order they were added.
+func (g *Graph[K, W]) ConnectedComponents() [][]K {
+	n := len(g.keys)
+	neighbors := g.adj
+	if g.directed {
+		// Follow edges both ways
+		neighbors = make([][]graphEdge[W], n)
+		for i, out := range g.adj {
+			for _, e := range out {
+				neighbors[i] = append(neighbors[i], e)
+				neighbors[e.to] = append(neighbors[e.to], graphEdge[W]{i, e.weight})
+			}
+		}
+	}
+
+	var components [][]K
+	visited := make([]bool, n)
+	queue := NewQueue[int]()
+	for s := range n {
+		if visited[s] {
+			continue
+		}
+		visited[s] = true
+		queue.Enqueue(s)
+
+		members := []int{}
+		for !queue.IsEmpty() {
+			current, _ := queue.Dequeue()
+			members = append(members, current)
+			for _, e := range neighbors[current] {
+				if !visited[e.to] {
+					visited[e.to] = true
+					queue.Enqueue(e.to)
+				}
+			}
+		}
+		components = append(components, g.keysOf(members))
+	}
+	return components
+}
+
+// StronglyConnectedComponents splits the vertices into groups where every
+// vertex can reach every other one, using Tarjan's algorithm with an
+// explicit stack. Groups come out in reverse topological order: no edge
+// leads from a group to an earlier one. The vertices of each group are in
+// the order they were added. In an undirected graph these are the connected
+// components.
+func (g *Graph[K, W]) StronglyConnectedComponents() [][]K {
+	n := len(g.keys)
+	index := make([]int, n) // order of discovery, starting at 1; 0 means not visited
+	low := make([]int, n)   // smallest index reachable through the search tree and one back edge
+	onStack := make([]bool, n)
+	stack := []int{}
+	counter := 0
+
+	type frame struct {
+		vertex int
+		next   int // index of the next edge to follow
+	}
+
+	var components [][]K
+	for s := range n {
+		if index[s] != 0 {
+			continue
+		}
+
+		calls := []frame{{s, 0}}
+		counter++
+		index[s], low[s] = counter, counter
+		stack = append(stack, s)
+		onStack[s] = true
+
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.vertex
+
+			if top.next < len(g.adj[v]) {
+				w := g.adj[v][top.next].to
+				top.next++
+				if index[w] == 0 {
+					counter++
+					index[w], low[w] = counter, counter
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{w, 0})
+				} else if onStack[w] {
+					low[v] = min(low[v], index[w])
+				}
+				continue
+			}
+
+			// All edges of v are done: v is the root of a component if
+			// nothing below it reaches further up
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].vertex
+				low[parent] = min(low[parent], low[v])
+			}
+			if low[v] == index[v] {
+				members := []int{}
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					members = append(members, w)
+					if w == v {
+						break
+					}
+				}
+				components = append(components, g.keysOf(members))
+			}
+		}
+	}
+	return components
+}
+
+// keysOf returns the vertices with the given indices in the order they were
+// added to the graph.
+func (g *Graph[K, W]) keysOf(indices []int) []K {
+	slices.Sort(indices)
+	keys := make([]K, len(indices))
+	for i, v := range indices {
+		keys[i] = g.keys[v]
+	}
+	return keys
+}
@@ -0,0 +1,242 @@
+package collections
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	g := NewDirectedGraph[string, int]()
+	g.AddEdge("app", "http", 1)
+	g.AddEdge("app", "db", 1)
+	g.AddEdge("http", "net", 1)
+	g.AddEdge("db", "net", 1)
+	g.AddEdge("db", "log", 1)
+	g.AddVertex("docs")
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(order, []string{"app", "http", "db", "net", "log", "docs"}) {
+		t.Errorf("Unexpected order %v", order)
+	}
+
+	// Among the ready vertices the one added first wins, even over a vertex
+	// that became ready earlier
+	abc := NewDirectedGraph[string, int]()
+	abc.AddVertex("a")
+	abc.AddVertex("c")
+	abc.AddVertex("b")
+	abc.AddEdge("a", "c", 1)
+	if order, err := abc.TopologicalSort(); err != nil || !slices.Equal(order, []string{"a", "c", "b"}) {
+		t.Errorf("Expected [a c b], got %v (error %v)", order, err)
+	}
+
+	// log -> http -> net is fine, net -> app closes a cycle
+	g.AddEdge("log", "http", 1)
+	g.AddEdge("net", "app", 1)
+	_, err = g.TopologicalSort()
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) || !errors.Is(err, ErrCycle) {
+		t.Fatalf("Expected a CycleError, got %v", err)
+	}
+	if !slices.Equal(cycleErr.Cycle, []string{"app", "db", "net"}) && !slices.Equal(cycleErr.Cycle, []string{"app", "http", "net"}) {
+		t.Errorf("Unexpected cycle %v", cycleErr.Cycle)
+	}
+	checkCycle(t, g, cycleErr.Cycle)
+	if err.Error() != "collections: graph has a cycle: "+joinCycle(cycleErr.Cycle) {
+		t.Errorf("Unexpected message %q", err.Error())
+	}
+
+	// A self-loop is a cycle of one vertex
+	self := NewDirectedGraph[int, int]()
+	self.AddEdge(1, 2, 1)
+	self.AddEdge(2, 2, 1)
+	if _, err := self.TopologicalSort(); err == nil || err.Error() != "collections: graph has a cycle: 2 -> 2" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if _, err := NewGraph[int, int]().TopologicalSort(); err == nil {
+		t.Error("Expected an error for an undirected graph")
+	}
+	if order, err := NewDirectedGraph[int, int]().TopologicalSort(); err != nil || len(order) != 0 {
+		t.Error("An empty graph should sort to nothing")
+	}
+}
+
+// checkCycle verifies that every vertex of cycle has an edge to the next.
+func checkCycle[K comparable, W Number](t *testing.T, g *Graph[K, W], cycle []K) {
+	t.Helper()
+	for i, v := range cycle {
+		if !g.HasEdge(v, cycle[(i+1)%len(cycle)]) {
+			t.Fatalf("%v is not a cycle", cycle)
+		}
+	}
+}
+
+func joinCycle(cycle []string) string {
+	s := ""
+	for _, v := range cycle {
+		s += v + " -> "
+	}
+	return s + cycle[0]
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := NewGraph[int, int]()
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(5, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddVertex(6)
+	g.AddEdge(3, 7, 1)
+
+	expected := [][]int{{1, 2, 4}, {5, 3, 7}, {6}}
+	if got := g.ConnectedComponents(); !slices.EqualFunc(got, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// Directed graphs give weakly connected components
+	d := NewDirectedGraph[string, int]()
+	d.AddEdge("a", "b", 1)
+	d.AddEdge("c", "b", 1)
+	d.AddEdge("d", "e", 1)
+	if got := d.ConnectedComponents(); !slices.EqualFunc(got, [][]string{{"a", "b", "c"}, {"d", "e"}}, slices.Equal) {
+		t.Errorf("Unexpected components %v", got)
+	}
+
+	if NewGraph[int, int]().ConnectedComponents() != nil {
+		t.Error("An empty graph has no components")
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := NewDirectedGraph[string, int]()
+	// {a, b, c} -> {d, e} -> {f}, plus g alone
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "a", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("d", "e", 1)
+	g.AddEdge("e", "d", 1)
+	g.AddEdge("e", "f", 1)
+	g.AddVertex("g")
+
+	expected := [][]string{{"f"}, {"d", "e"}, {"a", "b", "c"}, {"g"}}
+	if got := g.StronglyConnectedComponents(); !slices.EqualFunc(got, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	u := NewGraph[int, int]()
+	u.AddEdge(1, 2, 1)
+	u.AddEdge(3, 4, 1)
+	if got := u.StronglyConnectedComponents(); len(got) != 2 {
+		t.Errorf("Expected the connected components, got %v", got)
+	}
+
+	// A long chain does not grow the call stack
+	long := NewDirectedGraph[int, int]()
+	for i := range 100_000 {
+		long.AddEdge(i, i+1, 1)
+	}
+	long.AddEdge(100_000, 0, 1)
+	if got := long.StronglyConnectedComponents(); len(got) != 1 || len(got[0]) != 100_001 {
+		t.Errorf("Expected one big component, got %d", len(got))
+	}
+}
+
+func TestGraphOrderRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	for round := range 100 {
+		n := 1 + r.IntN(15)
+		g := NewDirectedGraph[int, int]()
+		for i := range n {
+			g.AddVertex(i)
+		}
+		acyclic := round%2 == 0
+		for range r.IntN(n * 2) {
+			from, to := r.IntN(n), r.IntN(n)
+			if acyclic && from >= to {
+				continue
+			}
+			g.AddEdge(from, to, 1)
+		}
+
+		// Reachability by BFS as the reference
+		reach := make([][]bool, n)
+		for i := range n {
+			reach[i] = make([]bool, n)
+			g.BFS(i, func(v int) bool { reach[i][v] = true; return true })
+		}
+
+		order, err := g.TopologicalSort()
+		if acyclic && err != nil {
+			t.Fatalf("Round %d: unexpected error: %v", round, err)
+		}
+		if err == nil {
+			position := make(map[int]int)
+			for i, v := range order {
+				position[v] = i
+			}
+			for _, e := range g.Edges() {
+				if position[e.From] >= position[e.To] {
+					t.Fatalf("Round %d: edge %d -> %d goes backwards in %v", round, e.From, e.To, order)
+				}
+			}
+			// Each vertex is the smallest one whose predecessors are all placed
+			placed := make([]bool, n)
+			for i, v := range order {
+				for u := range v {
+					ready := !placed[u]
+					for _, e := range g.Edges() {
+						if e.To == u && !placed[e.From] {
+							ready = false
+						}
+					}
+					if ready {
+						t.Fatalf("Round %d: %d was ready before %d at position %d of %v", round, u, v, i, order)
+					}
+				}
+				placed[v] = true
+			}
+		} else {
+			var cycleErr *CycleError[int]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("Round %d: expected a CycleError, got %v", round, err)
+			}
+			checkCycle(t, g, cycleErr.Cycle)
+		}
+
+		// Two vertices share a component exactly when they reach each other
+		components := g.StronglyConnectedComponents()
+		component := make([]int, n)
+		seen := 0
+		for c, members := range components {
+			for _, v := range members {
+				component[v] = c
+				seen++
+			}
+		}
+		if seen != n {
+			t.Fatalf("Round %d: components cover %d of %d vertices", round, seen, n)
+		}
+		for i := range n {
+			for j := range n {
+				if (component[i] == component[j]) != (reach[i][j] && reach[j][i]) {
+					t.Fatalf("Round %d: %d and %d are grouped wrongly in %v", round, i, j, components)
+				}
+			}
+		}
+		// Reverse topological order: no edge leads to a later component
+		for _, e := range g.Edges() {
+			if component[e.From] < component[e.To] {
+				t.Fatalf("Round %d: edge %d -> %d leads to a later component", round, e.From, e.To)
+			}
+		}
+		if acyclic && len(components) != n {
+			t.Fatalf("Round %d: an acyclic graph should have %d components, got %d", round, n, len(components))
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package collections
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	// ErrNegativeWeight is returned by Dijkstra when the graph has an edge
+	// with a negative weight. Use BellmanFord for such graphs.
+	ErrNegativeWeight = errors.New("collections: negative edge weight")
+
+	// ErrNegativeCycle is returned by BellmanFord when a cycle whose weights
+	// add up to less than zero can be reached from the source, so some
+	// distances have no minimum.
+	ErrNegativeCycle = errors.New("collections: negative cycle")
+)
+
+// ShortestPaths holds the shortest distances and paths from one source
+// vertex, as found by Dijkstra or BellmanFord.
+type ShortestPaths[K comparable, W Number] struct {
+	source K
+	dist   map[K]W
+	prev   map[K]K // the vertex before each reached vertex on its path
+}
+
+func newShortestPaths[K comparable, W Number](g *Graph[K, W], source int, dist []W, reached []bool, prev []int) *ShortestPaths[K, W] {
+	p := &ShortestPaths[K, W]{
+		source: g.keys[source],
+		dist:   make(map[K]W),
+		prev:   make(map[K]K),
+	}
+	for i, ok := range reached {
+		if !ok {
+			continue
+		}
+		p.dist[g.keys[i]] = dist[i]
+		if prev[i] >= 0 {
+			p.prev[g.keys[i]] = g.keys[prev[i]]
+		}
+	}
+	return p
+}
+
+// Source returns the vertex the paths start from.
+func (p *ShortestPaths[K, W]) Source() K {
+	return p.source
+}
+
+// DistanceTo returns the total weight of the shortest path to a vertex.
+// Returns false if the vertex cannot be reached from the source.
+func (p *ShortestPaths[K, W]) DistanceTo(key K) (W, bool) {
+	d, ok := p.dist[key]
+	return d, ok
+}
+
+// PathTo returns the vertices on the shortest path from the source to a
+// vertex, both included. Returns nil if the vertex cannot be reached.
+func (p *ShortestPaths[K, W]) PathTo(key K) []K {
+	if _, ok := p.dist[key]; !ok {
+		return nil
+	}
+	path := []K{key}
+	for key != p.source {
+		key = p.prev[key]
+		path = append(path, key)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// distItem is a vertex and its tentative distance in the Dijkstra heap.
+type distItem[W Number] struct {
+	vertex int
+	dist   W
+}
+
+// distHeap is a min-heap of distItems for container/heap.
+type distHeap[W Number] []distItem[W]
+
+func (h distHeap[W]) Len() int           { return len(h) }
+func (h distHeap[W]) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h distHeap[W]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *distHeap[W]) Push(x any)        { *h = append(*h, x.(distItem[W])) }
+func (h *distHeap[W]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// Dijkstra finds the shortest paths from source to every vertex it can
+// reach, using a binary heap. It takes O((V + E) log V) time. Every edge
+// weight must be zero or more; otherwise it returns an error wrapping
+// ErrNegativeWeight. Returns an error wrapping ErrVertexNotFound if source
+// is not in the graph.
+func (g *Graph[K, W]) Dijkstra(source K) (*ShortestPaths[K, W], error) {
+	s, ok := g.index[source]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrVertexNotFound, source)
+	}
+	for i, out := range g.adj {
+		for _, e := range out {
+			if e.weight < 0 {
+				return nil, fmt.Errorf("%w: %v to %v weighs %v", ErrNegativeWeight, g.keys[i], g.keys[e.to], e.weight)
+			}
+		}
+	}
+
+	n := len(g.keys)
+	dist := make([]W, n)
+	reached := make([]bool, n)
+	done := make([]bool, n)
+	prev := make([]int, n)
+	for i := range prev {
+		prev[i] = -1
+	}
+
+	reached[s] = true
+	h := &distHeap[W]{{s, 0}}
+	for h.Len() > 0 {
+		current := heap.Pop(h).(distItem[W])
+		if done[current.vertex] {
+			continue // a stale entry for a vertex already settled
+		}
+		done[current.vertex] = true
+
+		for _, e := range g.adj[current.vertex] {
+			d := current.dist + e.weight
+			if !reached[e.to] || d < dist[e.to] {
+				reached[e.to] = true
+				dist[e.to] = d
+				prev[e.to] = current.vertex
+				heap.Push(h, distItem[W]{e.to, d})
+			}
+		}
+	}
+	return newShortestPaths(g, s, dist, reached, prev), nil
+}
+
+// BellmanFord finds the shortest paths from source to every vertex it can
+// reach. Unlike Dijkstra it allows negative edge weights, but it takes
+// O(V * E) time. Returns an error wrapping ErrNegativeCycle if a negative
+// cycle can be reached from source; in an undirected graph any negative edge
+// is such a cycle. Returns an error wrapping ErrVertexNotFound if source is
+// not in the graph.
+func (g *Graph[K, W]) BellmanFord(source K) (*ShortestPaths[K, W], error) {
+	s, ok := g.index[source]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrVertexNotFound, source)
+	}
+
+	n := len(g.keys)
+	dist := make([]W, n)
+	reached := make([]bool, n)
+	prev := make([]int, n)
+	for i := range prev {
+		prev[i] = -1
+	}
+	reached[s] = true
+
+	// After n-1 rounds every shortest path has been found, so a change in
+	// round n means a negative cycle
+	for range n {
+		changed := false
+		for i, out := range g.adj {
+			if !reached[i] {
+				continue
+			}
+			for _, e := range out {
+				if d := dist[i] + e.weight; !reached[e.to] || d < dist[e.to] {
+					reached[e.to] = true
+					dist[e.to] = d
+					prev[e.to] = i
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return newShortestPaths(g, s, dist, reached, prev), nil
+		}
+	}
+	return nil, fmt.Errorf("%w reachable from %v", ErrNegativeCycle, source)
+}
@@ -0,0 +1,193 @@
+package collections
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestDijkstra(t *testing.T) {
+	g := NewDirectedGraph[string, int]()
+	g.AddEdge("s", "a", 7)
+	g.AddEdge("s", "b", 2)
+	g.AddEdge("b", "a", 3)
+	g.AddEdge("a", "t", 1)
+	g.AddEdge("b", "t", 8)
+	g.AddEdge("t", "s", 0)
+	g.AddVertex("island")
+
+	paths, err := g.Dijkstra("s")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if paths.Source() != "s" {
+		t.Errorf("Expected source s, got %s", paths.Source())
+	}
+
+	tests := []struct {
+		to   string
+		dist int
+		path []string
+	}{
+		{"s", 0, []string{"s"}},
+		{"b", 2, []string{"s", "b"}},
+		{"a", 5, []string{"s", "b", "a"}},
+		{"t", 6, []string{"s", "b", "a", "t"}},
+	}
+	for _, tt := range tests {
+		if d, ok := paths.DistanceTo(tt.to); !ok || d != tt.dist {
+			t.Errorf("DistanceTo(%s) = %d, expected %d", tt.to, d, tt.dist)
+		}
+		if got := paths.PathTo(tt.to); !slices.Equal(got, tt.path) {
+			t.Errorf("PathTo(%s) = %v, expected %v", tt.to, got, tt.path)
+		}
+	}
+	if _, ok := paths.DistanceTo("island"); ok || paths.PathTo("island") != nil {
+		t.Error("An unreachable vertex should have no distance or path")
+	}
+
+	if _, err := g.Dijkstra("nowhere"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+	g.AddEdge("a", "b", -1)
+	if _, err := g.Dijkstra("s"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	g := NewDirectedGraph[int, float64]()
+	g.AddEdge(0, 1, 4)
+	g.AddEdge(0, 2, 5)
+	g.AddEdge(1, 3, 3)
+	g.AddEdge(2, 1, -2.5)
+	g.AddEdge(3, 2, 1)
+
+	paths, err := g.BellmanFord(0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d, _ := paths.DistanceTo(3); d != 5.5 {
+		t.Errorf("Expected distance 5.5, got %v", d)
+	}
+	if got := paths.PathTo(3); !slices.Equal(got, []int{0, 2, 1, 3}) {
+		t.Errorf("Unexpected path %v", got)
+	}
+
+	// 1 -> 3 -> 2 -> 1 now weighs -0.5
+	g.AddEdge(1, 3, 1)
+	if _, err := g.BellmanFord(0); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, got %v", err)
+	}
+
+	// A cycle that cannot be reached does not matter
+	g.AddVertex(9)
+	g.AddEdge(9, 0, 1)
+	if _, err := g.BellmanFord(9); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle from 9, got %v", err)
+	}
+	h := NewDirectedGraph[int, int]()
+	h.AddEdge(1, 2, -1)
+	h.AddEdge(2, 1, -1)
+	h.AddEdge(0, 3, 2)
+	h.AddEdge(3, 1, 1)
+	if paths, err := h.BellmanFord(3); err == nil {
+		t.Errorf("Expected a negative cycle, got %v", paths)
+	}
+	if _, err := h.BellmanFord(0); err == nil {
+		t.Error("Expected a negative cycle reachable from 0")
+	}
+	h.RemoveEdge(3, 1)
+	if paths, err := h.BellmanFord(0); err != nil || paths.PathTo(1) != nil {
+		t.Errorf("Unreachable cycle should be ignored, got %v", err)
+	}
+
+	// In an undirected graph a negative edge is a cycle
+	u := NewGraph[int, int]()
+	u.AddEdge(0, 1, -1)
+	if _, err := u.BellmanFord(0); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, got %v", err)
+	}
+	if _, err := u.BellmanFord(5); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected ErrVertexNotFound, got %v", err)
+	}
+}
+
+func TestShortestPathsRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	const inf = 1 << 30
+
+	for round := range 50 {
+		n := 2 + r.IntN(12)
+		g := NewDirectedGraph[int, int]()
+		if round%2 == 1 {
+			g = NewGraph[int, int]()
+		}
+		for i := range n {
+			g.AddVertex(i)
+		}
+		for range r.IntN(n * 3) {
+			g.AddEdge(r.IntN(n), r.IntN(n), r.IntN(20))
+		}
+
+		// Floyd-Warshall as the reference
+		dist := make([][]int, n)
+		for i := range dist {
+			dist[i] = make([]int, n)
+			for j := range dist[i] {
+				dist[i][j] = inf
+			}
+			dist[i][i] = 0
+		}
+		for _, e := range g.Edges() {
+			dist[e.From][e.To] = min(dist[e.From][e.To], e.Weight)
+			if !g.IsDirected() {
+				dist[e.To][e.From] = min(dist[e.To][e.From], e.Weight)
+			}
+		}
+		for k := range n {
+			for i := range n {
+				for j := range n {
+					dist[i][j] = min(dist[i][j], dist[i][k]+dist[k][j])
+				}
+			}
+		}
+
+		for s := range n {
+			dijkstra, err := g.Dijkstra(s)
+			if err != nil {
+				t.Fatalf("Round %d: unexpected error: %v", round, err)
+			}
+			bellman, err := g.BellmanFord(s)
+			if err != nil {
+				t.Fatalf("Round %d: unexpected error: %v", round, err)
+			}
+
+			for v := range n {
+				for name, paths := range map[string]*ShortestPaths[int, int]{"Dijkstra": dijkstra, "BellmanFord": bellman} {
+					d, ok := paths.DistanceTo(v)
+					if ok != (dist[s][v] < inf) || (ok && d != dist[s][v]) {
+						t.Fatalf("Round %d: %s distance %d -> %d is %d, expected %d", round, name, s, v, d, dist[s][v])
+					}
+					if !ok {
+						continue
+					}
+
+					// The path must be made of real edges adding up to the distance
+					path, total := paths.PathTo(v), 0
+					for i := 1; i < len(path); i++ {
+						w, exists := g.Weight(path[i-1], path[i])
+						if !exists {
+							t.Fatalf("Round %d: %s path %v uses a missing edge", round, name, path)
+						}
+						total += w
+					}
+					if path[0] != s || path[len(path)-1] != v || total != d {
+						t.Fatalf("Round %d: %s path %v does not match distance %d", round, name, path, d)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// queueJSON is the envelope used for bounded queues.
+type queueJSON[T any] struct {
+	Capacity int `json:"capacity"`
+	Values   []T `json:"values"`
+}
+
+// listJSON is the envelope used for circular linked lists.
+type listJSON[T any] struct {
+	Circular bool `json:"circular"`
+	Values   []T  `json:"values"`
+}
+
+// treeJSON is the nested object written for every node of a Tree.
+// Missing children are left out.
+type treeJSON[T any] struct {
+	Value T            `json:"value"`
+	Left  *treeJSON[T] `json:"left,omitempty"`
+	Right *treeJSON[T] `json:"right,omitempty"`
+}
+
+// treeDecodeJSON mirrors treeJSON but keeps the raw value so a node without
+// a "value" field can be reported as an error.
+type treeDecodeJSON struct {
+	Value json.RawMessage `json:"value"`
+	Left  *treeDecodeJSON `json:"left"`
+	Right *treeDecodeJSON `json:"right"`
+}
+
+// unmarshalEnvelope decodes data into envelope if it is a JSON object and
+// into values otherwise, so containers accept both a plain array and the
+// envelope form.
+func unmarshalEnvelope(data []byte, values, envelope any) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		return json.Unmarshal(data, envelope)
+	}
+	return json.Unmarshal(data, values)
+}
+
+// MarshalJSON encodes the queue as a JSON array from front to back.
+// A bounded queue is wrapped as {"capacity": n, "values": [...]} so the
+// capacity survives a round trip.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	if q.capacity > 0 {
+		return json.Marshal(queueJSON[T]{Capacity: q.capacity, Values: q.ToSlice()})
+	}
+	return json.Marshal(q.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the queue with a JSON array or a
+// {"capacity": n, "values": [...]} envelope. A plain array keeps the
+// capacity of the queue; only the envelope changes it.
+// Returns an error if the values do not fit the capacity.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	decoded := queueJSON[T]{Capacity: q.capacity}
+	if err := unmarshalEnvelope(data, &decoded.Values, &decoded); err != nil {
+		return err
+	}
+	if decoded.Capacity < 0 {
+		return fmt.Errorf("collections: invalid queue capacity %d", decoded.Capacity)
+	}
+	if decoded.Capacity > 0 && len(decoded.Values) > decoded.Capacity {
+		return fmt.Errorf("collections: %d values do not fit a queue with capacity %d", len(decoded.Values), decoded.Capacity)
+	}
+
+	q.Clear()
+	q.capacity = decoded.Capacity
+	q.EnqueueAll(decoded.Values)
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array from head to tail.
+// A circular list is wrapped as {"circular": true, "values": [...]}.
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	if l.circular {
+		return json.Marshal(listJSON[T]{Circular: true, Values: l.ToSlice()})
+	}
+	return json.Marshal(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the list with a JSON array or a
+// {"circular": true, "values": [...]} envelope. A plain array keeps the
+// circular flag of the list; only the envelope changes it.
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	decoded := listJSON[T]{Circular: l.circular}
+	if err := unmarshalEnvelope(data, &decoded.Values, &decoded); err != nil {
+		return err
+	}
+
+	l.Clear()
+	l.circular = decoded.Circular
+	l.AppendAll(decoded.Values)
+	return nil
+}
+
+// MarshalJSON encodes the tree as nested {"value": v, "left": ..., "right": ...}
+// objects starting at the root. Missing children are left out and an empty
+// tree is encoded as null.
+func (t *Tree[T]) MarshalJSON() ([]byte, error) {
+	if t.root == nil {
+		return []byte("null"), nil
+	}
+
+	type pair struct {
+		from *Node[T]
+		to   *treeJSON[T]
+	}
+
+	root := &treeJSON[T]{Value: t.root.Value}
+	stack := []pair{{t.root, root}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current.from.Left != nil {
+			current.to.Left = &treeJSON[T]{Value: current.from.Left.Value}
+			stack = append(stack, pair{current.from.Left, current.to.Left})
+		}
+		if current.from.Right != nil {
+			current.to.Right = &treeJSON[T]{Value: current.from.Right.Value}
+			stack = append(stack, pair{current.from.Right, current.to.Right})
+		}
+	}
+	return json.Marshal(root)
+}
+
+// UnmarshalJSON replaces the contents of the tree with the shape and values
+// of nested {"value": v, "left": ..., "right": ...} objects, where null or a
+// missing field is an empty child. The compare function of the tree is kept.
+// encoding/json does not decode nesting deeper than 10000 levels.
+func (t *Tree[T]) UnmarshalJSON(data []byte) error {
+	var decoded *treeDecodeJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded == nil {
+		t.Clear()
+		return nil
+	}
+
+	type pair struct {
+		from *treeDecodeJSON
+		to   *Node[T]
+	}
+
+	newNode := func(from *treeDecodeJSON) (*Node[T], error) {
+		if len(from.Value) == 0 {
+			return nil, fmt.Errorf("collections: tree node without a value")
+		}
+		node := &Node[T]{}
+		if err := json.Unmarshal(from.Value, &node.Value); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+
+	root, err := newNode(decoded)
+	if err != nil {
+		return err
+	}
+	size := 1
+	stack := []pair{{decoded, root}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current.from.Left != nil {
+			if current.to.Left, err = newNode(current.from.Left); err != nil {
+				return err
+			}
+			stack = append(stack, pair{current.from.Left, current.to.Left})
+			size++
+		}
+		if current.from.Right != nil {
+			if current.to.Right, err = newNode(current.from.Right); err != nil {
+				return err
+			}
+			stack = append(stack, pair{current.from.Right, current.to.Right})
+			size++
+		}
+	}
+
+	t.root = root
+	t.size = size
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array. The node capacity is not stored.
+func (l *UnrolledList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the list with a JSON array.
+// The node capacity of the list is kept.
+func (l *UnrolledList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	if l.nodeCapacity == 0 {
+		l.nodeCapacity = defaultUnrolledNodeCapacity
+	}
+	l.Clear()
+	for _, v := range values {
+		l.Append(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the tree as a JSON array in ascending order.
+func (t *AVLTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.InOrder())
+}
+
+// UnmarshalJSON replaces the contents of the tree with the values of a JSON
+// array, in any order. Duplicates are ignored.
+// The tree must have been created with NewAVLTree or NewAVLTreeFunc.
+func (t *AVLTree[T]) UnmarshalJSON(data []byte) error {
+	if t.compare == nil {
+		return fmt.Errorf("collections: cannot unmarshal into %T without a compare function", t)
+	}
+
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	t.Clear()
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array in ascending order.
+func (s *TreeSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the set with the values of a JSON
+// array, in any order. Duplicates are ignored.
+// The set must have been created with NewTreeSet or NewTreeSetFunc.
+func (s *TreeSet[T]) UnmarshalJSON(data []byte) error {
+	if s.m == nil {
+		return fmt.Errorf("collections: cannot unmarshal into %T without a compare function", s)
+	}
+
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array, in no particular order.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the set with the values of a JSON
+// array. Duplicates are ignored.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.Clear()
+	s.AddAll(values...)
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON array of {"key": k, "value": v}
+// objects in ascending key order, so keys of any type can be stored.
+func (m *TreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	entries := make([]Entry[K, V], 0, m.size)
+	m.ForEach(func(key K, value V) {
+		entries = append(entries, Entry[K, V]{key, value})
+	})
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON replaces the contents of the map with a JSON array of
+// {"key": k, "value": v} objects. A later entry wins over an earlier one
+// with the same key.
+// The map must have been created with NewTreeMap or NewTreeMapFunc.
+func (m *TreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m.compare == nil {
+		return fmt.Errorf("collections: cannot unmarshal into %T without a compare function", m)
+	}
+
+	var entries []Entry[K, V]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	m.Clear()
+	for _, entry := range entries {
+		m.Put(entry.Key, entry.Value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the skip list as a JSON array of {"key": k, "value": v}
+// objects in ascending key order.
+func (s *SkipList[K, V]) MarshalJSON() ([]byte, error) {
+	entries := make([]Entry[K, V], 0, s.size)
+	if s.size > 0 {
+		s.ForEach(func(key K, value V) {
+			entries = append(entries, Entry[K, V]{key, value})
+		})
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON replaces the contents of the skip list with a JSON array of
+// {"key": k, "value": v} objects. A later entry wins over an earlier one
+// with the same key.
+// The skip list must have been created with NewSkipList or NewSkipListFunc.
+func (s *SkipList[K, V]) UnmarshalJSON(data []byte) error {
+	if s.compare == nil {
+		return fmt.Errorf("collections: cannot unmarshal into %T without a compare function", s)
+	}
+
+	var entries []Entry[K, V]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, entry := range entries {
+		s.Insert(entry.Key, entry.Value)
+	}
+	return nil
+}
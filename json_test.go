@@ -0,0 +1,298 @@
+package collections
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestQueueJSON(t *testing.T) {
+	q := NewQueue[int]()
+	q.EnqueueAll([]int{1, 2, 3})
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected [1,2,3], got %s", data)
+	}
+
+	decoded := NewQueue[int]()
+	decoded.Enqueue(99)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(decoded.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", decoded.ToSlice())
+	}
+
+	empty, _ := json.Marshal(NewQueue[int]())
+	if string(empty) != "[]" {
+		t.Errorf("Expected [], got %s", empty)
+	}
+}
+
+func TestBoundedQueueJSON(t *testing.T) {
+	q := NewBoundedQueue[string](5)
+	q.EnqueueAll([]string{"a", "b"})
+
+	data, _ := json.Marshal(q)
+	if string(data) != `{"capacity":5,"values":["a","b"]}` {
+		t.Errorf("Unexpected encoding %s", data)
+	}
+
+	var decoded Queue[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Len() != 2 || decoded.EnqueueAll([]string{"c", "d", "e", "f"}) != 3 {
+		t.Error("Decoded queue should keep capacity 5")
+	}
+
+	// A plain array keeps the capacity of the queue
+	bounded := NewBoundedQueue[string](3)
+	data = []byte(`["x","y"]`)
+	if err := json.Unmarshal(data, bounded); err != nil || bounded.Len() != 2 {
+		t.Fatalf("Unexpected result %v (error %v)", bounded, err)
+	}
+	if bounded.EnqueueAll([]string{"z", "w"}) != 1 {
+		t.Error("Decoding a plain array should keep capacity 3")
+	}
+	if err := json.Unmarshal([]byte(`["a","b","c","d"]`), bounded); err == nil {
+		t.Error("Expected an error for values that do not fit capacity 3")
+	}
+	if bounded.Len() != 3 {
+		t.Error("A failed decode should leave the queue unchanged")
+	}
+
+	// Only the envelope changes it
+	if err := json.Unmarshal([]byte(`{"capacity":0,"values":["a","b","c","d"]}`), bounded); err != nil || bounded.Len() != 4 {
+		t.Errorf("Expected an unbounded queue of 4, got %v (error %v)", bounded, err)
+	}
+
+	tests := []string{
+		`{"capacity":1,"values":[1,2]}`,
+		`{"capacity":-1,"values":[]}`,
+		`["a"]`,
+		`{"values":`,
+	}
+	for _, input := range tests {
+		if err := json.Unmarshal([]byte(input), NewQueue[int]()); err == nil {
+			t.Errorf("Expected an error for %s", input)
+		}
+	}
+}
+
+func TestLinkedListJSON(t *testing.T) {
+	list := NewLinkedListFrom(1, 2, 3)
+	data, _ := json.Marshal(list)
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected [1,2,3], got %s", data)
+	}
+
+	circular := NewCircularLinkedList[int]()
+	circular.AppendAll([]int{4, 5})
+	data, _ = json.Marshal(circular)
+	if string(data) != `{"circular":true,"values":[4,5]}` {
+		t.Errorf("Unexpected encoding %s", data)
+	}
+
+	decoded := NewLinkedListFrom(9)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.IsCircular() || !decoded.Equal(circular, nil) {
+		t.Errorf("Expected circular [4 5], got %v", decoded)
+	}
+	if err := decoded.Validate(); err != nil {
+		t.Errorf("Decoded list is invalid: %v", err)
+	}
+
+	// A plain array keeps the circular flag of the list
+	if err := json.Unmarshal([]byte("[7, 8]"), decoded); err != nil || !decoded.IsCircular() || decoded.Validate() != nil {
+		t.Errorf("Expected a circular list, got %v (error %v)", decoded, err)
+	}
+	data, _ = json.Marshal(NewLinkedListFrom(1, 2))
+	if err := json.Unmarshal(data, decoded); err != nil || !decoded.IsCircular() || decoded.Len() != 2 {
+		t.Errorf("A regular list decoded into a circular one should stay circular, got %v", decoded)
+	}
+
+	// Only the envelope changes it
+	if err := json.Unmarshal([]byte(`{"circular":false,"values":[3]}`), decoded); err != nil || decoded.IsCircular() {
+		t.Errorf("Expected a regular list, got %v (error %v)", decoded, err)
+	}
+	regular := NewLinkedList[int]()
+	if err := json.Unmarshal([]byte("[7]"), regular); err != nil || regular.IsCircular() {
+		t.Errorf("Expected a regular list, got %v (error %v)", regular, err)
+	}
+}
+
+func TestTreeJSON(t *testing.T) {
+	tree := newSampleTree()
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"value":1,"left":{"value":2,"left":{"value":4},"right":{"value":5,"right":{"value":7}}},"right":{"value":3,"right":{"value":6}}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	decoded := NewTree[int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(tree, nil) || decoded.Size() != tree.Size() {
+		t.Errorf("Round trip changed the tree: %v", decoded.ToSlice())
+	}
+
+	// Explicit nulls are the same as missing children
+	err = json.Unmarshal([]byte(`{"value":1,"left":null,"right":{"value":2,"left":null}}`), decoded)
+	if err != nil || decoded.Size() != 2 || decoded.root.Left != nil || decoded.root.Right.Value != 2 {
+		t.Errorf("Unexpected tree %v (error %v)", decoded.ToSlice(), err)
+	}
+
+	empty, _ := json.Marshal(NewTree[int]())
+	if string(empty) != "null" {
+		t.Errorf("Expected null, got %s", empty)
+	}
+	if err := json.Unmarshal([]byte("null"), decoded); err != nil || !decoded.IsEmpty() {
+		t.Errorf("null should give an empty tree (error %v)", err)
+	}
+}
+
+func TestTreeJSONErrors(t *testing.T) {
+	tests := []string{
+		`{"left":{"value":1}}`,
+		`{"value":1,"left":{"right":{"value":2}}}`,
+		`{"value":"one"}`,
+		`[1,2]`,
+	}
+
+	for _, input := range tests {
+		tree := newSampleTree()
+		if err := json.Unmarshal([]byte(input), tree); err == nil {
+			t.Errorf("Expected an error for %s", input)
+		}
+		if tree.Size() != 7 {
+			t.Errorf("A failed decode should leave the tree unchanged for %s", input)
+		}
+	}
+}
+
+func TestTreeJSONKeepsCompare(t *testing.T) {
+	bst := NewBST[int]()
+	if err := json.Unmarshal([]byte(`{"value":5,"left":{"value":3},"right":{"value":8}}`), bst); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !bst.IsValidBST() || !bst.InsertBST(4) {
+		t.Error("Decoded BST should keep its compare function")
+	}
+}
+
+func TestSortedContainersJSON(t *testing.T) {
+	avl := NewAVLTree[int]()
+	if err := json.Unmarshal([]byte("[5,1,3,1]"), avl); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, _ := json.Marshal(avl)
+	if string(data) != "[1,3,5]" {
+		t.Errorf("Expected [1,3,5], got %s", data)
+	}
+
+	set := NewTreeSet[string]()
+	if err := json.Unmarshal([]byte(`["b","a"]`), set); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, _ = json.Marshal(set)
+	if string(data) != `["a","b"]` {
+		t.Errorf(`Expected ["a","b"], got %s`, data)
+	}
+
+	m := NewTreeMap[int, string]()
+	m.Put(2, "b")
+	m.Put(1, "a")
+	data, _ = json.Marshal(m)
+	if string(data) != `[{"key":1,"value":"a"},{"key":2,"value":"b"}]` {
+		t.Errorf("Unexpected encoding %s", data)
+	}
+	decodedMap := NewTreeMap[int, string]()
+	if err := json.Unmarshal(data, decodedMap); err != nil || decodedMap.String() != m.String() {
+		t.Errorf("Expected %v, got %v (error %v)", m, decodedMap, err)
+	}
+
+	skip := NewSkipList[int, string]()
+	if err := json.Unmarshal(data, skip); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data2, _ := json.Marshal(skip)
+	if string(data2) != string(data) {
+		t.Errorf("Expected %s, got %s", data, data2)
+	}
+
+	unrolled := NewUnrolledListSize[int](2)
+	if err := json.Unmarshal([]byte("[1,2,3,4,5]"), unrolled); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, _ = json.Marshal(unrolled)
+	if string(data) != "[1,2,3,4,5]" {
+		t.Errorf("Expected [1,2,3,4,5], got %s", data)
+	}
+}
+
+func TestJSONWithoutCompare(t *testing.T) {
+	targets := map[string]json.Unmarshaler{
+		"AVLTree":  &AVLTree[int]{},
+		"TreeSet":  &TreeSet[int]{},
+		"TreeMap":  &TreeMap[int, int]{},
+		"SkipList": &SkipList[int, int]{},
+	}
+
+	for name, target := range targets {
+		err := target.UnmarshalJSON([]byte("[]"))
+		if err == nil || !strings.Contains(err.Error(), "compare function") {
+			t.Errorf("%s: expected a missing compare error, got %v", name, err)
+		}
+	}
+
+	// Zero values of the other containers are usable
+	var list UnrolledList[int]
+	if err := json.Unmarshal([]byte("[1,2]"), &list); err != nil || list.Len() != 2 {
+		t.Errorf("Expected 2 values in a zero UnrolledList, got %d (error %v)", list.Len(), err)
+	}
+}
+
+func TestJSONNested(t *testing.T) {
+	type payload struct {
+		Jobs  *Queue[string]        `json:"jobs"`
+		Steps *LinkedList[int]      `json:"steps"`
+		Plan  *Tree[string]         `json:"plan"`
+		Index *TreeMap[string, int] `json:"index"`
+	}
+
+	in := payload{
+		Jobs:  NewQueue[string](),
+		Steps: NewLinkedListFrom(1, 2),
+		Plan:  NewTree[string](),
+		Index: NewTreeMap[string, int](),
+	}
+	in.Jobs.Enqueue("build")
+	in.Plan.Insert("root")
+	in.Index.Put("x", 1)
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out := payload{Index: NewTreeMap[string, int]()}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if out.Jobs.Len() != 1 || out.Steps.Len() != 2 || out.Plan.Size() != 1 || out.Index.Len() != 1 {
+		t.Errorf("Nested round trip lost values: %s", data)
+	}
+}
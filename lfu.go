@@ -0,0 +1,217 @@
+package collections
+
+// lfuBucket holds the entries that have been used freq times, most recently
+// used first. Buckets form a doubly linked list in ascending order of freq.
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries entryList[K, V]
+	prev    *lfuBucket[K, V]
+	next    *lfuBucket[K, V]
+}
+
+// LFUCache is a fixed-size cache that evicts the least frequently used entry
+// when it is full. Ties are broken by evicting the least recently used of
+// those entries. Entries are kept in one list per use count, and the lists
+// are linked in order of count, so every operation takes O(1) time.
+//
+// LFUCache is not safe for concurrent use.
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*cacheEntry[K, V]
+	buckets  map[int]*lfuBucket[K, V] // by use count
+	root     lfuBucket[K, V]          // sentinel; root.next has the lowest count
+	onEvict  func(K, V)
+	stats    CacheStats
+}
+
+// NewLFUCache creates and returns a new empty cache holding up to capacity
+// entries. Capacities below 1 are raised to 1.
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	c := &LFUCache[K, V]{capacity: max(capacity, 1)}
+	c.Clear()
+	return c
+}
+
+// OnEvict sets a function called with every entry that is evicted to make
+// room. It is not called by Remove, Clear or when Put replaces a value.
+// A nil fn removes the callback.
+func (c *LFUCache[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// insertBucket adds an empty bucket for freq after prev.
+func (c *LFUCache[K, V]) insertBucket(prev *lfuBucket[K, V], freq int) *lfuBucket[K, V] {
+	b := &lfuBucket[K, V]{freq: freq, prev: prev, next: prev.next}
+	b.entries.init()
+	prev.next.prev = b
+	prev.next = b
+	c.buckets[freq] = b
+	return b
+}
+
+// unlink removes e from its bucket, dropping the bucket if it becomes empty.
+func (c *LFUCache[K, V]) unlink(e *cacheEntry[K, V]) {
+	b := c.buckets[e.freq]
+	b.entries.remove(e)
+	if b.entries.len == 0 {
+		b.prev.next = b.next
+		b.next.prev = b.prev
+		delete(c.buckets, b.freq)
+	}
+}
+
+// touch moves e to the bucket for one more use.
+func (c *LFUCache[K, V]) touch(e *cacheEntry[K, V]) {
+	b := c.buckets[e.freq]
+	next := b.next
+	if next == &c.root || next.freq != e.freq+1 {
+		next = c.insertBucket(b, e.freq+1)
+	}
+	c.unlink(e)
+	e.freq++
+	next.entries.pushFront(e)
+}
+
+// Get returns the value for a key and counts one more use of it.
+// Returns false if the key is not in the cache.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value for a key without counting a use, a hit or a miss.
+// Returns false if the key is not in the cache.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains checks if the key is in the cache without counting a use.
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Frequency returns how many times the key has been used, counting the Put
+// that added it. Returns 0 if the key is not in the cache.
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	if e, ok := c.items[key]; ok {
+		return e.freq
+	}
+	return 0
+}
+
+// Put adds or replaces the value for a key. Replacing a value counts as a use.
+// If the cache is full, the least frequently used entry is evicted.
+// Returns true if a new key was added.
+func (c *LFUCache[K, V]) Put(key K, value V) bool {
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		return false
+	}
+
+	if len(c.items) >= c.capacity {
+		victim := c.root.next.entries.back()
+		c.unlink(victim)
+		delete(c.items, victim.key)
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(victim.key, victim.value)
+		}
+	}
+
+	b := c.root.next
+	if b == &c.root || b.freq != 1 {
+		b = c.insertBucket(&c.root, 1)
+	}
+	e := &cacheEntry[K, V]{key: key, value: value, freq: 1}
+	b.entries.pushFront(e)
+	c.items[key] = e
+	return true
+}
+
+// Remove removes a key from the cache.
+// Returns false if the key is not in the cache.
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.unlink(e)
+	delete(c.items, key)
+	return true
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Len returns the number of entries in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// IsEmpty returns true if the cache has no entries.
+func (c *LFUCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// Clear removes all entries from the cache. The stats are kept.
+func (c *LFUCache[K, V]) Clear() {
+	c.items = make(map[K]*cacheEntry[K, V])
+	c.buckets = make(map[int]*lfuBucket[K, V])
+	c.root.next = &c.root
+	c.root.prev = &c.root
+}
+
+// ForEach applies a function to each entry, from the most to the least
+// frequently used. Entries used equally often are visited from the most to
+// the least recently used.
+func (c *LFUCache[K, V]) ForEach(fn func(K, V)) {
+	for b := c.root.prev; b != &c.root; b = b.prev {
+		for e := b.entries.root.next; e != &b.entries.root; e = e.next {
+			fn(e.key, e.value)
+		}
+	}
+}
+
+// Keys returns the keys in the order used by ForEach.
+func (c *LFUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	c.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Stats returns the hit, miss and eviction counters.
+func (c *LFUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero.
+func (c *LFUCache[K, V]) ResetStats() {
+	c.stats = CacheStats{}
+}
+
+// String returns a string representation of the entries, from the most to
+// the least frequently used.
+func (c *LFUCache[K, V]) String() string {
+	if len(c.items) == 0 {
+		return "LFUCache{empty}"
+	}
+	return "LFUCache{" + formatEntries(c.ForEach) + "}"
+}
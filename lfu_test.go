@@ -0,0 +1,127 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkLFUBuckets verifies that the buckets are linked in ascending order of
+// use count and hold every entry exactly once.
+func checkLFUBuckets[K comparable, V any](t *testing.T, c *LFUCache[K, V]) {
+	t.Helper()
+	count, buckets, prevFreq := 0, 0, 0
+	for b := c.root.next; b != &c.root; b = b.next {
+		if b.freq <= prevFreq || b.entries.len == 0 || c.buckets[b.freq] != b || b.next.prev != b {
+			t.Fatalf("Bucket for %d is out of place", b.freq)
+		}
+		for e := b.entries.root.next; e != &b.entries.root; e = e.next {
+			if e.freq != b.freq || c.items[e.key] != e {
+				t.Fatalf("Entry %v is in the wrong bucket", e.key)
+			}
+			count++
+		}
+		prevFreq = b.freq
+		buckets++
+	}
+	if count != len(c.items) || buckets != len(c.buckets) {
+		t.Fatalf("%d buckets hold %d entries, expected %d and %d", buckets, count, len(c.buckets), len(c.items))
+	}
+}
+
+func TestNewLFUCache(t *testing.T) {
+	c := NewLFUCache[string, int](3)
+	if c == nil {
+		t.Fatal("NewLFUCache() returned nil")
+	}
+	if !c.IsEmpty() || c.Capacity() != 3 {
+		t.Error("New cache should be empty with capacity 3")
+	}
+	if str := c.String(); str != "LFUCache{empty}" {
+		t.Errorf("Expected 'LFUCache{empty}', got '%s'", str)
+	}
+	if NewLFUCache[int, int](-5).Capacity() != 1 {
+		t.Error("Capacity below 1 should be raised to 1")
+	}
+}
+
+func TestLFUCacheEviction(t *testing.T) {
+	c := NewLFUCache[string, int](3)
+	var evicted []string
+	c.OnEvict(func(key string, _ int) { evicted = append(evicted, key) })
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	checkLFUBuckets(t, c)
+
+	if c.Frequency("a") != 3 || c.Frequency("b") != 2 || c.Frequency("c") != 1 || c.Frequency("z") != 0 {
+		t.Errorf("Unexpected frequencies a=%d b=%d c=%d", c.Frequency("a"), c.Frequency("b"), c.Frequency("c"))
+	}
+	if !slices.Equal(c.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", c.Keys())
+	}
+
+	// The least frequently used key goes first, however recent it is
+	c.Put("d", 4)
+	if !slices.Equal(evicted, []string{"c"}) {
+		t.Errorf("Expected c evicted, got %v", evicted)
+	}
+
+	// Ties go to the least recently used key
+	c.Get("d")
+	c.Put("e", 5)
+	if !slices.Equal(evicted, []string{"c", "b"}) {
+		t.Errorf("Expected b evicted, got %v", evicted)
+	}
+	if str := c.String(); str != "LFUCache{a: 1, d: 4, e: 5}" {
+		t.Errorf("Unexpected string %s", str)
+	}
+	checkLFUBuckets(t, c)
+
+	// Replacing a value counts as a use and Peek does not
+	c.Put("e", 50)
+	c.Peek("e")
+	c.Contains("e")
+	if c.Frequency("e") != 2 {
+		t.Errorf("Expected frequency 2, got %d", c.Frequency("e"))
+	}
+
+	if !c.Remove("d") || c.Remove("d") {
+		t.Error("Remove should succeed once")
+	}
+	checkLFUBuckets(t, c)
+	c.Put("f", 6)
+	c.Put("g", 7)
+	if !slices.Equal(evicted, []string{"c", "b", "f"}) {
+		t.Errorf("Expected f evicted, got %v", evicted)
+	}
+
+	c.Clear()
+	checkLFUBuckets(t, c)
+	c.Put("h", 8)
+	if c.Len() != 1 || c.Frequency("h") != 1 {
+		t.Error("Cache should work after Clear")
+	}
+
+	c.ResetStats()
+	if c.Stats() != (CacheStats{}) {
+		t.Error("ResetStats should zero the counters")
+	}
+}
+
+func TestLFUCacheBuckets(t *testing.T) {
+	c := NewLFUCache[int, int](50)
+	for i := range 200 {
+		c.Put(i%70, i)
+		for range i % 5 {
+			c.Get((i * 7) % 70)
+		}
+		if i%9 == 0 {
+			c.Remove(i % 13)
+		}
+		checkLFUBuckets(t, c)
+	}
+}
@@ -1,6 +1,13 @@
 package collections
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidList is returned by Validate when the node chain of a list does
+// not match its bookkeeping.
+var ErrInvalidList = errors.New("collections: invalid linked list")
 
 // ListNode represents a node in a linked list.
 type ListNode[T any] struct {
@@ -29,6 +36,13 @@ func NewCircularLinkedList[T any]() *LinkedList[T] {
 	return &LinkedList[T]{circular: true}
 }
 
+// NewLinkedListFrom creates and returns a new linked list holding the given values in order.
+func NewLinkedListFrom[T any](values ...T) *LinkedList[T] {
+	l := NewLinkedList[T]()
+	l.AppendAll(values)
+	return l
+}
+
 // NewListNode creates and returns a new list node with the given value.
 func NewListNode[T any](value T) *ListNode[T] {
 	return &ListNode[T]{Value: value}
@@ -74,6 +88,41 @@ func (l *LinkedList[T]) Prepend(value T) {
 	l.size++
 }
 
+// AppendAll adds all values to the end of the list, keeping their order.
+func (l *LinkedList[T]) AppendAll(values []T) {
+	for _, value := range values {
+		l.Append(value)
+	}
+}
+
+// PrependAll adds all values to the beginning of the list, keeping their order.
+func (l *LinkedList[T]) PrependAll(values []T) {
+	if len(values) == 0 {
+		return
+	}
+
+	first := NewListNode(values[0])
+	last := first
+	for _, value := range values[1:] {
+		last.Next = NewListNode(value)
+		last = last.Next
+	}
+
+	if l.head == nil {
+		l.tail = last
+		if l.circular {
+			last.Next = first
+		}
+	} else {
+		last.Next = l.head
+		if l.circular {
+			l.tail.Next = first
+		}
+	}
+	l.head = first
+	l.size += len(values)
+}
+
 // InsertAt inserts a value at the specified index.
 // Returns false if the index is out of bounds.
 func (l *LinkedList[T]) InsertAt(index int, value T) bool {
@@ -228,6 +277,87 @@ func (l *LinkedList[T]) Remove(value T) bool {
 	return false
 }
 
+// RemoveAll removes every occurrence of the specified value.
+// Returns the number of elements removed.
+func (l *LinkedList[T]) RemoveAll(value T) int {
+	return l.RemoveIf(func(v T) bool {
+		return any(v) == any(value)
+	})
+}
+
+// RemoveIf removes every element that matches the predicate.
+// Returns the number of elements removed.
+func (l *LinkedList[T]) RemoveIf(fn func(T) bool) int {
+	removed := 0
+	var prev *ListNode[T]
+	current := l.head
+
+	for i := 0; i < l.size; i++ {
+		next := current.Next
+		if fn(current.Value) {
+			if prev == nil {
+				l.head = next
+			} else {
+				prev.Next = next
+			}
+			removed++
+		} else {
+			prev = current
+		}
+		current = next
+	}
+
+	l.size -= removed
+	l.tail = prev
+	if l.tail == nil {
+		l.head = nil
+	} else if l.circular {
+		l.tail.Next = l.head
+	} else {
+		l.tail.Next = nil
+	}
+	return removed
+}
+
+// Deduplicate removes every element equal to an earlier element in the list
+// in a single pass. If eq is nil, values are compared with == through a set
+// of the values seen so far, which takes O(n) time; values that cannot be
+// compared with ==, such as slices, are never removed. A custom eq is checked
+// against every kept element, which takes O(n²) time.
+// Returns the number of elements removed.
+func (l *LinkedList[T]) Deduplicate(eq func(a, b T) bool) int {
+	if eq == nil {
+		seen := make(map[any]struct{}, l.size)
+		return l.RemoveIf(func(v T) bool {
+			return seenBefore(seen, v)
+		})
+	}
+
+	kept := make([]T, 0, l.size)
+	return l.RemoveIf(func(v T) bool {
+		for _, k := range kept {
+			if eq(k, v) {
+				return true
+			}
+		}
+		kept = append(kept, v)
+		return false
+	})
+}
+
+// seenBefore adds value to seen and reports whether it was already there.
+// Values that cannot be map keys are never reported as seen.
+func seenBefore(seen map[any]struct{}, value any) bool {
+	if !hashable(value) {
+		return false
+	}
+	if _, found := seen[value]; found {
+		return true
+	}
+	seen[value] = struct{}{}
+	return false
+}
+
 // Get returns the value at the specified index.
 // Returns false if the index is out of bounds.
 func (l *LinkedList[T]) Get(index int) (T, bool) {
@@ -257,6 +387,37 @@ func (l *LinkedList[T]) GetFirst() (T, bool) {
 	return l.head.Value, true
 }
 
+// Enqueue adds a value to the end of the list. It always returns true and
+// lets LinkedList satisfy the FIFO interface.
+func (l *LinkedList[T]) Enqueue(value T) bool {
+	l.Append(value)
+	return true
+}
+
+// Dequeue removes and returns the first element of the list.
+// It is the same as RemoveFirst.
+func (l *LinkedList[T]) Dequeue() (T, bool) {
+	return l.RemoveFirst()
+}
+
+// Push adds a value to the front of the list, so the list can be used as a
+// stack with Pop and Peek.
+func (l *LinkedList[T]) Push(value T) {
+	l.Prepend(value)
+}
+
+// Pop removes and returns the first element of the list.
+// It is the same as RemoveFirst.
+func (l *LinkedList[T]) Pop() (T, bool) {
+	return l.RemoveFirst()
+}
+
+// Peek returns the first element of the list without removing it: the next
+// value returned by both Dequeue and Pop. It is the same as GetFirst.
+func (l *LinkedList[T]) Peek() (T, bool) {
+	return l.GetFirst()
+}
+
 // GetLast returns the last element in the list.
 // Returns false if the list is empty.
 func (l *LinkedList[T]) GetLast() (T, bool) {
@@ -307,6 +468,36 @@ func (l *LinkedList[T]) Find(value T) *ListNode[T] {
 	return nil
 }
 
+// Equal reports whether both lists hold equal values in the same order.
+// If eq is nil, values are compared with ==. Circularity is not compared.
+// Returns false if other is nil.
+func (l *LinkedList[T]) Equal(other *LinkedList[T], eq func(a, b T) bool) bool {
+	if other == nil || l.size != other.size {
+		return false
+	}
+	if eq == nil {
+		eq = func(a, b T) bool { return any(a) == any(b) }
+	}
+
+	left, right := l.head, other.head
+	for i := 0; i < l.size; i++ {
+		if !eq(left.Value, right.Value) {
+			return false
+		}
+		left = left.Next
+		right = right.Next
+	}
+	return true
+}
+
+// Clone creates a copy of the list with new nodes.
+// The copy is circular if the original list is circular.
+func (l *LinkedList[T]) Clone() *LinkedList[T] {
+	newList := &LinkedList[T]{circular: l.circular}
+	l.ForEach(newList.Append)
+	return newList
+}
+
 // Len returns the number of elements in the list.
 func (l *LinkedList[T]) Len() int {
 	return l.size
@@ -430,3 +621,142 @@ func (l *LinkedList[T]) String() string {
 	result += "}"
 	return result
 }
+
+// DetectCycle reports whether the chain starting at head contains a cycle
+// using Floyd's tortoise and hare algorithm.
+// Returns the first node of the cycle and its length, or nil and 0 if the
+// chain is terminated by nil.
+func DetectCycle[T any](head *ListNode[T]) (*ListNode[T], int) {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			break
+		}
+	}
+	if fast == nil || fast.Next == nil {
+		return nil, 0
+	}
+
+	// Measure the cycle length
+	length := 1
+	for current := slow.Next; current != slow; current = current.Next {
+		length++
+	}
+
+	// Find the start of the cycle
+	start := head
+	for start != slow {
+		start = start.Next
+		slow = slow.Next
+	}
+
+	return start, length
+}
+
+// Validate checks that the node chain matches the list's head, tail, size
+// and circular flag. This is useful when nodes have been modified through
+// their exported Next field.
+// Returns nil if the list is consistent, or an error wrapping ErrInvalidList
+// describing the first inconsistency found.
+func (l *LinkedList[T]) Validate() error {
+	if l.head == nil {
+		if l.tail != nil {
+			return fmt.Errorf("%w: tail is set but head is nil", ErrInvalidList)
+		}
+		if l.size != 0 {
+			return fmt.Errorf("%w: size is %d but head is nil", ErrInvalidList, l.size)
+		}
+		return nil
+	}
+
+	if l.tail == nil {
+		return fmt.Errorf("%w: head is set but tail is nil", ErrInvalidList)
+	}
+
+	start, length := DetectCycle(l.head)
+
+	if start == nil {
+		if l.circular {
+			return fmt.Errorf("%w: circular list is terminated by nil", ErrInvalidList)
+		}
+
+		count := 1
+		last := l.head
+		for last.Next != nil {
+			last = last.Next
+			count++
+		}
+		if last != l.tail {
+			return fmt.Errorf("%w: tail is not the last node of the chain", ErrInvalidList)
+		}
+		if count != l.size {
+			return fmt.Errorf("%w: size is %d but chain has %d nodes", ErrInvalidList, l.size, count)
+		}
+		return nil
+	}
+
+	if !l.circular {
+		return fmt.Errorf("%w: non-circular list contains a cycle of length %d", ErrInvalidList, length)
+	}
+	if start != l.head {
+		return fmt.Errorf("%w: cycle does not start at head", ErrInvalidList)
+	}
+
+	last := l.head
+	for i := 1; i < length; i++ {
+		last = last.Next
+	}
+	if last != l.tail {
+		return fmt.Errorf("%w: tail does not point back to head", ErrInvalidList)
+	}
+	if length != l.size {
+		return fmt.Errorf("%w: size is %d but cycle has %d nodes", ErrInvalidList, l.size, length)
+	}
+	return nil
+}
+
+// Repair recomputes tail, size and the circular flag from the node chain
+// starting at head. A chain that loops back to head becomes a circular list.
+// A cycle that does not pass through head is broken at its back edge and
+// the list becomes a regular linked list.
+func (l *LinkedList[T]) Repair() {
+	if l.head == nil {
+		l.tail = nil
+		l.size = 0
+		return
+	}
+
+	start, length := DetectCycle(l.head)
+
+	if start == l.head {
+		last := l.head
+		for i := 1; i < length; i++ {
+			last = last.Next
+		}
+		l.tail = last
+		l.size = length
+		l.circular = true
+		return
+	}
+
+	if start != nil {
+		// Find the node whose Next closes the cycle and cut it
+		last := start
+		for last.Next != start {
+			last = last.Next
+		}
+		last.Next = nil
+	}
+
+	count := 1
+	last := l.head
+	for last.Next != nil {
+		last = last.Next
+		count++
+	}
+	l.tail = last
+	l.size = count
+	l.circular = false
+}
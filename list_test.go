@@ -1,6 +1,8 @@
 package collections
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -687,3 +689,331 @@ func TestSingleElementOperations(t *testing.T) {
 		t.Error("List should be empty after removing single element")
 	}
 }
+
+func TestDetectCycle(t *testing.T) {
+	// No cycle
+	list := NewLinkedList[int]()
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+
+	if start, length := DetectCycle(list.head); start != nil || length != 0 {
+		t.Errorf("Expected no cycle, got start %v and length %d", start, length)
+	}
+
+	// Empty chain
+	if start, length := DetectCycle[int](nil); start != nil || length != 0 {
+		t.Errorf("Expected no cycle for nil head, got start %v and length %d", start, length)
+	}
+
+	// Cycle starting in the middle: 1 -> 2 -> 3 -> 4 -> 2
+	list.Append(4)
+	second := list.head.Next
+	list.tail.Next = second
+
+	start, length := DetectCycle(list.head)
+	if start != second {
+		t.Errorf("Expected cycle to start at node 2, got %v", start)
+	}
+	if length != 3 {
+		t.Errorf("Expected cycle length 3, got %d", length)
+	}
+
+	// Circular list cycles back to head
+	circular := NewCircularLinkedList[int]()
+	circular.Append(1)
+	circular.Append(2)
+
+	start, length = DetectCycle(circular.head)
+	if start != circular.head {
+		t.Error("Expected cycle to start at head in circular list")
+	}
+	if length != 2 {
+		t.Errorf("Expected cycle length 2, got %d", length)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	list := NewLinkedList[int]()
+	if err := list.Validate(); err != nil {
+		t.Errorf("Empty list should be valid, got %v", err)
+	}
+
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid, got %v", err)
+	}
+
+	circular := NewCircularLinkedList[int]()
+	circular.Append(1)
+	circular.Append(2)
+	circular.Append(3)
+	if err := circular.Validate(); err != nil {
+		t.Errorf("Circular list should be valid, got %v", err)
+	}
+
+	// Node appended through the exported Next field
+	list.tail.Next = NewListNode(4)
+	if err := list.Validate(); !errors.Is(err, ErrInvalidList) {
+		t.Errorf("Expected ErrInvalidList for stale tail, got %v", err)
+	}
+	list.tail.Next = nil
+
+	// Accidental cycle
+	list.tail.Next = list.head.Next
+	if err := list.Validate(); !errors.Is(err, ErrInvalidList) {
+		t.Errorf("Expected ErrInvalidList for cycle, got %v", err)
+	}
+	list.tail.Next = nil
+
+	// Wrong size
+	list.size = 5
+	if err := list.Validate(); !errors.Is(err, ErrInvalidList) {
+		t.Errorf("Expected ErrInvalidList for wrong size, got %v", err)
+	}
+	list.size = 3
+
+	// Circular list with broken back edge
+	circular.tail.Next = nil
+	if err := circular.Validate(); !errors.Is(err, ErrInvalidList) {
+		t.Errorf("Expected ErrInvalidList for broken circle, got %v", err)
+	}
+}
+
+func TestRepair(t *testing.T) {
+	// Nodes appended through the exported Next field
+	list := NewLinkedList[int]()
+	list.Append(1)
+	list.tail.Next = NewListNode(2)
+	list.tail.Next.Next = NewListNode(3)
+
+	list.Repair()
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid after Repair(), got %v", err)
+	}
+	if list.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", list.Len())
+	}
+	if last, _ := list.GetLast(); last != 3 {
+		t.Errorf("Expected last element 3, got %d", last)
+	}
+
+	// Chain that loops back to head becomes circular
+	list.tail.Next = list.head
+	list.Repair()
+	if !list.IsCircular() {
+		t.Error("List should be circular after Repair() of a loop to head")
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid after Repair(), got %v", err)
+	}
+
+	// Cycle not passing through head is broken
+	list.BreakCircle()
+	list.tail.Next = list.head.Next
+	list.Repair()
+	if list.IsCircular() {
+		t.Error("List should not be circular after Repair() of an inner cycle")
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid after Repair(), got %v", err)
+	}
+
+	expected := []int{1, 2, 3}
+	slice := list.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+}
+
+func TestNewLinkedListFrom(t *testing.T) {
+	list := NewLinkedListFrom(1, 2, 3)
+
+	if list.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", list.Len())
+	}
+
+	expected := []int{1, 2, 3}
+	slice := list.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+
+	empty := NewLinkedListFrom[int]()
+	if !empty.IsEmpty() {
+		t.Error("List created from no values should be empty")
+	}
+}
+
+func TestAppendAllPrependAll(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.PrependAll([]int{3, 4})
+	list.AppendAll([]int{5, 6})
+	list.PrependAll([]int{1, 2})
+	list.PrependAll(nil)
+
+	expected := []int{1, 2, 3, 4, 5, 6}
+	slice := list.ToSlice()
+	if len(slice) != len(expected) {
+		t.Fatalf("Expected length %d, got %d", len(expected), len(slice))
+	}
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid, got %v", err)
+	}
+
+	circular := NewCircularLinkedList[int]()
+	circular.PrependAll([]int{2, 3})
+	circular.PrependAll([]int{1})
+	if err := circular.Validate(); err != nil {
+		t.Errorf("Circular list should be valid, got %v", err)
+	}
+	if first, _ := circular.GetFirst(); first != 1 {
+		t.Errorf("Expected first element 1, got %d", first)
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+	list := NewLinkedListFrom(2, 1, 2, 3, 2)
+
+	if removed := list.RemoveAll(2); removed != 3 {
+		t.Errorf("Expected 3 elements removed, got %d", removed)
+	}
+	if list.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", list.Len())
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("List should be valid, got %v", err)
+	}
+	if removed := list.RemoveAll(9); removed != 0 {
+		t.Errorf("Expected 0 elements removed, got %d", removed)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	circular := NewCircularLinkedList[int]()
+	circular.AppendAll([]int{1, 2, 3, 4, 5})
+
+	removed := circular.RemoveIf(func(v int) bool { return v%2 == 1 })
+	if removed != 3 {
+		t.Errorf("Expected 3 elements removed, got %d", removed)
+	}
+	if err := circular.Validate(); err != nil {
+		t.Errorf("Circular list should be valid, got %v", err)
+	}
+
+	expected := []int{2, 4}
+	slice := circular.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+
+	circular.RemoveIf(func(int) bool { return true })
+	if !circular.IsEmpty() {
+		t.Error("List should be empty after removing every element")
+	}
+	if err := circular.Validate(); err != nil {
+		t.Errorf("Empty list should be valid, got %v", err)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	list := NewLinkedListFrom("a", "B", "b", "a", "c")
+
+	removed := list.Deduplicate(func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	})
+	if removed != 2 {
+		t.Errorf("Expected 2 elements removed, got %d", removed)
+	}
+
+	expected := []string{"a", "B", "c"}
+	slice := list.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %s, got %s", i, val, slice[i])
+		}
+	}
+
+	numbers := NewLinkedListFrom(1, 1, 2)
+	if removed := numbers.Deduplicate(nil); removed != 1 {
+		t.Errorf("Expected 1 element removed, got %d", removed)
+	}
+
+	// Values that cannot be compared with == are kept instead of panicking
+	unhashable := NewLinkedListFrom([]int{1}, []int{1})
+	if removed := unhashable.Deduplicate(nil); removed != 0 || unhashable.Len() != 2 {
+		t.Errorf("Expected nothing removed, got %d", removed)
+	}
+	mixed := NewLinkedListFrom[any](1, []int{1}, "a", 1, map[string]int{}, "a")
+	if removed := mixed.Deduplicate(nil); removed != 2 || mixed.Len() != 4 {
+		t.Errorf("Expected 2 elements removed, got %d", removed)
+	}
+
+	// The default takes a single pass over a long list
+	long := NewLinkedList[int]()
+	for i := range 200_000 {
+		long.Append(i % 1000)
+	}
+	if removed := long.Deduplicate(nil); removed != 199_000 || long.Validate() != nil {
+		t.Errorf("Expected 199000 elements removed, got %d", removed)
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	a := NewLinkedListFrom(1, 2, 3)
+	b := NewLinkedListFrom(1, 2, 3)
+	c := NewLinkedListFrom(1, 2, 4)
+
+	if !a.Equal(b, nil) {
+		t.Error("Lists with the same values should be equal")
+	}
+	if a.Equal(c, nil) {
+		t.Error("Lists with different values should not be equal")
+	}
+	if a.Equal(NewLinkedListFrom(1, 2), nil) {
+		t.Error("Lists with different lengths should not be equal")
+	}
+
+	always := func(int, int) bool { return true }
+	if !a.Equal(c, always) {
+		t.Error("Equal should use the provided comparison")
+	}
+	if a.Equal(nil, always) || NewLinkedList[int]().Equal(nil, nil) {
+		t.Error("A list should not equal a nil list")
+	}
+}
+
+func TestListClone(t *testing.T) {
+	circular := NewCircularLinkedList[int]()
+	circular.AppendAll([]int{1, 2, 3})
+
+	clone := circular.Clone()
+
+	if !clone.IsCircular() {
+		t.Error("Clone of a circular list should be circular")
+	}
+	if !clone.Equal(circular, nil) {
+		t.Error("Clone should hold the same values")
+	}
+	if err := clone.Validate(); err != nil {
+		t.Errorf("Clone should be valid, got %v", err)
+	}
+
+	clone.Append(4)
+	if circular.Len() != 3 {
+		t.Error("Modifying the clone should not affect the original")
+	}
+}
@@ -0,0 +1,415 @@
+package collections
+
+import (
+	"sync"
+	"time"
+)
+
+// LRUCache is a fixed-size cache that evicts the least recently used entry
+// when it is full. A hash map finds entries and a doubly linked list keeps
+// them in order of use, so every operation takes O(1) time.
+//
+// Entries may have a time to live. Expired entries are removed when they are
+// next looked up, or by RemoveExpired.
+//
+// LRUCache is not safe for concurrent use; see SyncLRUCache.
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*cacheEntry[K, V]
+	order    entryList[K, V] // most recently used first
+	onEvict  func(K, V)
+	now      func() time.Time
+	stats    CacheStats
+}
+
+// NewLRUCache creates and returns a new empty cache holding up to capacity
+// entries. Capacities below 1 are raised to 1.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	c := &LRUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*cacheEntry[K, V]),
+		now:      time.Now,
+	}
+	c.order.init()
+	return c
+}
+
+// OnEvict sets a function called with every entry that is evicted to make
+// room or removed because it expired. It is not called by Remove, Clear or
+// when Put replaces a value. A nil fn removes the callback.
+func (c *LRUCache[K, V]) OnEvict(fn func(key K, value V)) {
+	c.onEvict = fn
+}
+
+// SetClock sets the function used to read the current time for TTLs.
+// A nil now restores time.Now.
+func (c *LRUCache[K, V]) SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	c.now = now
+}
+
+func (c *LRUCache[K, V]) expired(e *cacheEntry[K, V]) bool {
+	return !e.expires.IsZero() && !c.now().Before(e.expires)
+}
+
+func (c *LRUCache[K, V]) removeEntry(e *cacheEntry[K, V]) {
+	c.order.remove(e)
+	delete(c.items, e.key)
+}
+
+func (c *LRUCache[K, V]) evict(e *cacheEntry[K, V]) {
+	c.removeEntry(e)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+func (c *LRUCache[K, V]) expire(e *cacheEntry[K, V]) {
+	c.removeEntry(e)
+	c.stats.Expirations++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// Get returns the value for a key and marks it as the most recently used.
+// Returns false if the key is not in the cache or has expired.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	if c.expired(e) {
+		c.expire(e)
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.order.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value for a key without marking it as used or counting
+// a hit or miss. Returns false if the key is not in the cache or has expired.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok || c.expired(e) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains checks if the key is in the cache and has not expired, without
+// marking it as used.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// Put adds or replaces the value for a key and marks it as the most recently
+// used. The entry never expires. If the cache is full, the least recently
+// used entry is evicted. Returns true if a new key was added.
+func (c *LRUCache[K, V]) Put(key K, value V) bool {
+	return c.put(key, value, time.Time{})
+}
+
+// PutWithTTL is like Put, but the entry expires after ttl.
+// A ttl of zero or less means the entry never expires.
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+	return c.put(key, value, expires)
+}
+
+func (c *LRUCache[K, V]) put(key K, value V, expires time.Time) bool {
+	if e, ok := c.items[key]; ok {
+		if !c.expired(e) {
+			e.value = value
+			e.expires = expires
+			c.order.moveToFront(e)
+			return false
+		}
+		c.expire(e)
+	}
+
+	if c.order.len >= c.capacity {
+		c.evict(c.order.back())
+	}
+	e := &cacheEntry[K, V]{key: key, value: value, expires: expires}
+	c.items[key] = e
+	c.order.pushFront(e)
+	return true
+}
+
+// Remove removes a key from the cache.
+// Returns false if the key is not in the cache or has expired.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	if c.expired(e) {
+		c.expire(e)
+		return false
+	}
+	c.removeEntry(e)
+	return true
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (c *LRUCache[K, V]) RemoveExpired() int {
+	removed := 0
+	for e := c.order.root.next; e != &c.order.root; {
+		next := e.next
+		if c.expired(e) {
+			c.expire(e)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
+
+// Resize changes the capacity of the cache, evicting the least recently used
+// entries if there are too many. Capacities below 1 are raised to 1.
+// Returns the number of entries evicted.
+func (c *LRUCache[K, V]) Resize(capacity int) int {
+	c.capacity = max(capacity, 1)
+	evicted := 0
+	for c.order.len > c.capacity {
+		c.evict(c.order.back())
+		evicted++
+	}
+	return evicted
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been removed yet.
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.len
+}
+
+// IsEmpty returns true if the cache has no entries.
+func (c *LRUCache[K, V]) IsEmpty() bool {
+	return c.order.len == 0
+}
+
+// Clear removes all entries from the cache. The stats are kept.
+func (c *LRUCache[K, V]) Clear() {
+	clear(c.items)
+	c.order.init()
+}
+
+// Keys returns the keys of the live entries, from the most to the least
+// recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.order.len)
+	c.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Stats returns the hit, miss, eviction and expiration counters.
+func (c *LRUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero.
+func (c *LRUCache[K, V]) ResetStats() {
+	c.stats = CacheStats{}
+}
+
+// ForEach applies a function to each live entry, from the most to the least
+// recently used, without marking them as used.
+func (c *LRUCache[K, V]) ForEach(fn func(K, V)) {
+	for e := c.order.root.next; e != &c.order.root; e = e.next {
+		if !c.expired(e) {
+			fn(e.key, e.value)
+		}
+	}
+}
+
+// String returns a string representation of the live entries, from the most
+// to the least recently used.
+func (c *LRUCache[K, V]) String() string {
+	entries := formatEntries(c.ForEach)
+	if entries == "" {
+		return "LRUCache{empty}"
+	}
+	return "LRUCache{" + entries + "}"
+}
+
+// SyncLRUCache is an LRUCache that is safe for concurrent use by multiple
+// goroutines. Every method holds a single lock, because even Get changes the
+// order of the entries. The OnEvict and ForEach callbacks run while the lock
+// is held, so they must not call the cache.
+type SyncLRUCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache *LRUCache[K, V]
+}
+
+// NewSyncLRUCache creates and returns a new empty concurrency-safe cache
+// holding up to capacity entries. Capacities below 1 are raised to 1.
+func NewSyncLRUCache[K comparable, V any](capacity int) *SyncLRUCache[K, V] {
+	return &SyncLRUCache[K, V]{cache: NewLRUCache[K, V](capacity)}
+}
+
+// OnEvict sets a function called with every evicted or expired entry.
+// See LRUCache.OnEvict.
+func (c *SyncLRUCache[K, V]) OnEvict(fn func(key K, value V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.OnEvict(fn)
+}
+
+// SetClock sets the function used to read the current time for TTLs.
+// A nil now restores time.Now. The function may be called from several
+// goroutines.
+func (c *SyncLRUCache[K, V]) SetClock(now func() time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.SetClock(now)
+}
+
+// Get returns the value for a key and marks it as the most recently used.
+// Returns false if the key is not in the cache or has expired.
+func (c *SyncLRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// Peek returns the value for a key without marking it as used.
+// Returns false if the key is not in the cache or has expired.
+func (c *SyncLRUCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// Contains checks if the key is in the cache and has not expired.
+func (c *SyncLRUCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Contains(key)
+}
+
+// Put adds or replaces the value for a key. Returns true if a new key was added.
+func (c *SyncLRUCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Put(key, value)
+}
+
+// PutWithTTL is like Put, but the entry expires after ttl.
+func (c *SyncLRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.PutWithTTL(key, value, ttl)
+}
+
+// Remove removes a key from the cache.
+// Returns false if the key is not in the cache or has expired.
+func (c *SyncLRUCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Remove(key)
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (c *SyncLRUCache[K, V]) RemoveExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.RemoveExpired()
+}
+
+// Resize changes the capacity of the cache and returns the number of
+// entries evicted.
+func (c *SyncLRUCache[K, V]) Resize(capacity int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Resize(capacity)
+}
+
+// Capacity returns the maximum number of entries the cache holds.
+func (c *SyncLRUCache[K, V]) Capacity() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Capacity()
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been removed yet.
+func (c *SyncLRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// IsEmpty returns true if the cache has no entries.
+func (c *SyncLRUCache[K, V]) IsEmpty() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.IsEmpty()
+}
+
+// Clear removes all entries from the cache. The stats are kept.
+func (c *SyncLRUCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Clear()
+}
+
+// Keys returns the keys of the live entries, from the most to the least
+// recently used.
+func (c *SyncLRUCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Keys()
+}
+
+// ForEach applies a function to each live entry, from the most to the least
+// recently used.
+func (c *SyncLRUCache[K, V]) ForEach(fn func(K, V)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.ForEach(fn)
+}
+
+// Stats returns the hit, miss, eviction and expiration counters.
+func (c *SyncLRUCache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Stats()
+}
+
+// ResetStats sets all counters back to zero.
+func (c *SyncLRUCache[K, V]) ResetStats() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.ResetStats()
+}
+
+// String returns a string representation of the live entries, from the most
+// to the least recently used.
+func (c *SyncLRUCache[K, V]) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return "Sync" + c.cache.String()
+}
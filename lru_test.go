@@ -0,0 +1,285 @@
+package collections
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock for TTL tests that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestNewLRUCache(t *testing.T) {
+	c := NewLRUCache[string, int](2)
+	if c == nil {
+		t.Fatal("NewLRUCache() returned nil")
+	}
+	if !c.IsEmpty() || c.Capacity() != 2 {
+		t.Error("New cache should be empty with capacity 2")
+	}
+	if str := c.String(); str != "LRUCache{empty}" {
+		t.Errorf("Expected 'LRUCache{empty}', got '%s'", str)
+	}
+	if NewLRUCache[int, int](0).Capacity() != 1 {
+		t.Error("Capacity below 1 should be raised to 1")
+	}
+}
+
+func TestLRUCacheOperations(t *testing.T) {
+	c := NewLRUCache[string, int](3)
+	if !c.Put("a", 1) || !c.Put("b", 2) || !c.Put("c", 3) {
+		t.Error("Put of new keys should return true")
+	}
+	if c.Put("a", 10) {
+		t.Error("Put of an existing key should return false")
+	}
+	if !slices.Equal(c.Keys(), []string{"a", "c", "b"}) {
+		t.Errorf("Expected [a c b], got %v", c.Keys())
+	}
+
+	// Get marks the key as used, Peek does not
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Errorf("Expected 2, got %d", v)
+	}
+	if v, ok := c.Peek("c"); !ok || v != 3 {
+		t.Errorf("Expected 3, got %d", v)
+	}
+	if !slices.Equal(c.Keys(), []string{"b", "a", "c"}) {
+		t.Errorf("Expected [b a c], got %v", c.Keys())
+	}
+
+	// The least recently used key is evicted
+	c.Put("d", 4)
+	if c.Contains("c") {
+		t.Error("c should have been evicted")
+	}
+	if str := c.String(); str != "LRUCache{d: 4, b: 2, a: 10}" {
+		t.Errorf("Unexpected string %s", str)
+	}
+
+	if !c.Remove("b") || c.Remove("b") {
+		t.Error("Remove should succeed once")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", c.Len())
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Error("Get of a missing key should fail")
+	}
+
+	c.Clear()
+	if !c.IsEmpty() || len(c.Keys()) != 0 {
+		t.Error("Cache should be empty after Clear")
+	}
+	c.Put("e", 5)
+	if v, _ := c.Get("e"); v != 5 {
+		t.Error("Cache should work after Clear")
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	c := NewLRUCache[int, string](2)
+	var evicted []int
+	c.OnEvict(func(key int, value string) {
+		if value != strconv.Itoa(key) {
+			t.Errorf("Callback got %d: %s", key, value)
+		}
+		evicted = append(evicted, key)
+	})
+
+	for i := range 5 {
+		c.Put(i, strconv.Itoa(i))
+	}
+	if !slices.Equal(evicted, []int{0, 1, 2}) {
+		t.Errorf("Expected [0 1 2] evicted, got %v", evicted)
+	}
+
+	// Remove, Clear and replacing a value do not call the callback
+	c.Put(4, "4")
+	c.Remove(3)
+	c.Clear()
+	if len(evicted) != 3 {
+		t.Errorf("Expected no more evictions, got %v", evicted)
+	}
+
+	// Shrinking evicts the least recently used entries
+	for i := range 4 {
+		c.Resize(4)
+		c.Put(i, strconv.Itoa(i))
+	}
+	c.Get(0)
+	evicted = nil
+	if n := c.Resize(2); n != 2 {
+		t.Errorf("Expected 2 entries evicted, got %d", n)
+	}
+	if !slices.Equal(evicted, []int{1, 2}) || !slices.Equal(c.Keys(), []int{0, 3}) {
+		t.Errorf("Expected [1 2] evicted and [0 3] left, got %v and %v", evicted, c.Keys())
+	}
+	if c.Stats().Evictions != 5 {
+		t.Errorf("Expected 5 evictions, got %d", c.Stats().Evictions)
+	}
+
+	c.OnEvict(nil)
+	c.Put(9, "9")
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	c := NewLRUCache[string, int](10)
+	c.SetClock(clock.Now)
+
+	var expired []string
+	c.OnEvict(func(key string, _ int) { expired = append(expired, key) })
+
+	c.PutWithTTL("short", 1, time.Second)
+	c.PutWithTTL("long", 2, time.Minute)
+	c.PutWithTTL("zero", 3, 0)
+	c.Put("forever", 4)
+
+	clock.Advance(time.Second)
+	if _, ok := c.Peek("short"); ok {
+		t.Error("short should have expired")
+	}
+	if c.Len() != 4 {
+		t.Error("Peek should not remove expired entries")
+	}
+	if !slices.Equal(c.Keys(), []string{"forever", "zero", "long"}) {
+		t.Errorf("Keys should skip expired entries, got %v", c.Keys())
+	}
+	if _, ok := c.Get("short"); ok {
+		t.Error("Get of an expired key should fail")
+	}
+	if c.Len() != 3 || !slices.Equal(expired, []string{"short"}) {
+		t.Errorf("Get should remove the expired entry, got %v", expired)
+	}
+
+	// Put replaces the TTL of an existing key
+	c.PutWithTTL("zero", 30, time.Second)
+	c.Put("long", 20)
+	clock.Advance(time.Hour)
+	if c.Contains("zero") || !c.Contains("long") || !c.Contains("forever") {
+		t.Error("Put should replace the TTL")
+	}
+
+	if n := c.RemoveExpired(); n != 1 {
+		t.Errorf("Expected 1 expired entry removed, got %d", n)
+	}
+	if c.Remove("zero") {
+		t.Error("zero was already removed")
+	}
+
+	// An expired key counts as new when put again
+	c.PutWithTTL("again", 1, time.Second)
+	clock.Advance(time.Second)
+	if !c.Put("again", 2) {
+		t.Error("Put over an expired key should return true")
+	}
+
+	stats := c.Stats()
+	if stats.Expirations != 3 || stats.Misses != 1 || stats.Evictions != 0 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	c.SetClock(nil)
+	c.PutWithTTL("real", 1, time.Hour)
+	if !c.Contains("real") {
+		t.Error("SetClock(nil) should use the real clock")
+	}
+}
+
+func TestLRUCacheStats(t *testing.T) {
+	c := NewLRUCache[int, int](2)
+	if c.Stats().HitRatio() != 0 {
+		t.Error("Hit ratio without lookups should be 0")
+	}
+
+	c.Put(1, 1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	c.Peek(3)
+	c.Contains(4)
+
+	stats := c.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 {
+		t.Errorf("Expected 3 hits and 1 miss, got %+v", stats)
+	}
+	if stats.HitRatio() != 0.75 {
+		t.Errorf("Expected hit ratio 0.75, got %f", stats.HitRatio())
+	}
+
+	c.Clear()
+	if c.Stats() != stats {
+		t.Error("Clear should keep the stats")
+	}
+	c.ResetStats()
+	if c.Stats() != (CacheStats{}) {
+		t.Error("ResetStats should zero the counters")
+	}
+}
+
+func TestSyncLRUCache(t *testing.T) {
+	c := NewSyncLRUCache[int, int](100)
+	evictions := 0
+	c.OnEvict(func(int, int) { evictions++ })
+
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				key := (g*1000 + i) % 250
+				if _, ok := c.Get(key); !ok {
+					c.Put(key, key)
+				}
+				c.Peek(key)
+				c.Contains(key)
+				if i%100 == 0 {
+					c.Remove(key)
+					c.Len()
+					c.Keys()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.Len() > c.Capacity() {
+		t.Errorf("Cache grew past its capacity: %d", c.Len())
+	}
+	stats := c.Stats()
+	if stats.Hits+stats.Misses != 8000 {
+		t.Errorf("Expected 8000 lookups, got %+v", stats)
+	}
+	if stats.Evictions != evictions {
+		t.Errorf("Expected %d evictions, got %d", evictions, stats.Evictions)
+	}
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c.SetClock(clock.Now)
+	c.Clear()
+	c.ResetStats()
+	c.PutWithTTL(1, 1, time.Second)
+	c.Put(2, 2)
+	clock.Advance(time.Second)
+	if c.RemoveExpired() != 1 || c.IsEmpty() {
+		t.Error("RemoveExpired should remove only the expired entry")
+	}
+	if c.Resize(1) != 0 || c.String() != "SyncLRUCache{2: 2}" {
+		t.Errorf("Unexpected state %s", c)
+	}
+	sum := 0
+	c.ForEach(func(k, v int) { sum += k + v })
+	if sum != 4 {
+		t.Errorf("Expected sum 4, got %d", sum)
+	}
+}
@@ -0,0 +1,113 @@
+package collections
+
+import (
+	"cmp"
+	"math"
+)
+
+// OrderStatisticTree represents an AVL tree where every node also stores the
+// size of its subtree. This answers "what is the k-th value" and "how many
+// values are smaller than v" in O(log n) time.
+// Values are unique; to store repeated scores, order them by a tie-breaker
+// such as an ID with NewOrderStatisticTreeFunc.
+type OrderStatisticTree[T any] struct {
+	AVLTree[T]
+}
+
+// NewOrderStatisticTree creates and returns a new empty order-statistic tree
+// ordered by the natural order of T.
+func NewOrderStatisticTree[T cmp.Ordered]() *OrderStatisticTree[T] {
+	return NewOrderStatisticTreeFunc(cmp.Compare[T])
+}
+
+// NewOrderStatisticTreeFunc creates and returns a new empty order-statistic
+// tree ordered by compare.
+func NewOrderStatisticTreeFunc[T any](compare func(a, b T) int) *OrderStatisticTree[T] {
+	return &OrderStatisticTree[T]{AVLTree: AVLTree[T]{compare: compare}}
+}
+
+// Select returns the k-th smallest value, where k is zero-based.
+// Returns false if k is out of bounds.
+func (t *OrderStatisticTree[T]) Select(k int) (T, bool) {
+	var zero T
+
+	if k < 0 || k >= t.size {
+		return zero, false
+	}
+
+	current := t.root
+	for current != nil {
+		leftSize := avlSize(current.left)
+		switch {
+		case k < leftSize:
+			current = current.left
+		case k == leftSize:
+			return current.value, true
+		default:
+			k -= leftSize + 1
+			current = current.right
+		}
+	}
+	return zero, false
+}
+
+// Rank returns the number of values in the tree that are less than value.
+// If value is in the tree, this is its zero-based position.
+func (t *OrderStatisticTree[T]) Rank(value T) int {
+	return t.countBelow(value, false)
+}
+
+// countBelow returns the number of values less than value, or less than or
+// equal to value when inclusive is true.
+func (t *OrderStatisticTree[T]) countBelow(value T, inclusive bool) int {
+	count := 0
+	current := t.root
+	for current != nil {
+		c := t.compare(current.value, value)
+		if c < 0 || (c == 0 && inclusive) {
+			count += avlSize(current.left) + 1
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return count
+}
+
+// CountRange returns the number of values in [lo, hi], including both ends.
+func (t *OrderStatisticTree[T]) CountRange(lo, hi T) int {
+	if t.compare(lo, hi) > 0 {
+		return 0
+	}
+	return t.countBelow(hi, true) - t.countBelow(lo, false)
+}
+
+// Percentile returns the value at percentile p (from 0 to 100) using the
+// nearest-rank method. Percentile(0) is the smallest value and
+// Percentile(100) the largest.
+// Returns false if the tree is empty or p is out of range.
+func (t *OrderStatisticTree[T]) Percentile(p float64) (T, bool) {
+	var zero T
+
+	if t.size == 0 || p < 0 || p > 100 {
+		return zero, false
+	}
+
+	k := int(math.Ceil(p/100*float64(t.size))) - 1
+	return t.Select(max(k, 0))
+}
+
+// Median returns the lower median of the values.
+// Returns false if the tree is empty.
+func (t *OrderStatisticTree[T]) Median() (T, bool) {
+	return t.Select((t.size - 1) / 2)
+}
+
+// PercentileRank returns the percentage of values in the tree that are less
+// than value. Returns 0 for an empty tree.
+func (t *OrderStatisticTree[T]) PercentileRank(value T) float64 {
+	if t.size == 0 {
+		return 0
+	}
+	return float64(t.Rank(value)) * 100 / float64(t.size)
+}
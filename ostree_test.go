@@ -0,0 +1,188 @@
+package collections
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkSubtreeSizes verifies that every node stores the size of its subtree.
+// Returns the size of the subtree.
+func checkSubtreeSizes[T any](t *testing.T, node *avlNode[T]) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+	size := 1 + checkSubtreeSizes(t, node.left) + checkSubtreeSizes(t, node.right)
+	if node.size != size {
+		t.Errorf("Node %v stores size %d, actual size is %d", node.value, node.size, size)
+	}
+	return size
+}
+
+func TestNewOrderStatisticTree(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	if tree == nil {
+		t.Fatal("NewOrderStatisticTree() returned nil")
+	}
+	if !tree.IsEmpty() {
+		t.Error("New tree should be empty")
+	}
+	if _, ok := tree.Select(0); ok {
+		t.Error("Select on empty tree should fail")
+	}
+	if _, ok := tree.Median(); ok {
+		t.Error("Median on empty tree should fail")
+	}
+	if rank := tree.PercentileRank(5); rank != 0 {
+		t.Errorf("Expected percentile rank 0, got %f", rank)
+	}
+}
+
+func TestSelectAndRank(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for _, val := range []int{50, 20, 80, 10, 30, 70, 90} {
+		tree.Insert(val)
+	}
+
+	expected := []int{10, 20, 30, 50, 70, 80, 90}
+	for k, val := range expected {
+		if got, ok := tree.Select(k); !ok || got != val {
+			t.Errorf("Expected Select(%d) = %d, got %d", k, val, got)
+		}
+		if rank := tree.Rank(val); rank != k {
+			t.Errorf("Expected Rank(%d) = %d, got %d", val, k, rank)
+		}
+	}
+
+	if _, ok := tree.Select(7); ok {
+		t.Error("Select out of bounds should fail")
+	}
+	if _, ok := tree.Select(-1); ok {
+		t.Error("Select with negative index should fail")
+	}
+	if rank := tree.Rank(55); rank != 4 {
+		t.Errorf("Expected Rank(55) = 4, got %d", rank)
+	}
+}
+
+func TestCountRange(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i * 10)
+	}
+
+	tests := []struct {
+		lo, hi   int
+		expected int
+	}{
+		{10, 100, 10},
+		{25, 55, 3},
+		{30, 30, 1},
+		{31, 39, 0},
+		{0, 5, 0},
+		{60, 20, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tree.CountRange(tt.lo, tt.hi); got != tt.expected {
+			t.Errorf("CountRange(%d, %d) = %d; expected %d", tt.lo, tt.hi, got, tt.expected)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for i := 1; i <= 100; i++ {
+		tree.Insert(i)
+	}
+
+	tests := []struct {
+		p        float64
+		expected int
+	}{
+		{0, 1},
+		{1, 1},
+		{50, 50},
+		{90, 90},
+		{99.5, 100},
+		{100, 100},
+	}
+
+	for _, tt := range tests {
+		if got, ok := tree.Percentile(tt.p); !ok || got != tt.expected {
+			t.Errorf("Percentile(%v) = %d; expected %d", tt.p, got, tt.expected)
+		}
+	}
+
+	if _, ok := tree.Percentile(101); ok {
+		t.Error("Percentile above 100 should fail")
+	}
+	if median, _ := tree.Median(); median != 50 {
+		t.Errorf("Expected median 50, got %d", median)
+	}
+	if rank := tree.PercentileRank(26); rank != 25 {
+		t.Errorf("Expected percentile rank 25, got %f", rank)
+	}
+}
+
+func TestOrderStatisticTreeWithTieBreaker(t *testing.T) {
+	type entry struct {
+		score  int
+		player string
+	}
+
+	// Highest score first, ties broken by player name
+	tree := NewOrderStatisticTreeFunc(func(a, b entry) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.player, b.player)
+	})
+	tree.Insert(entry{100, "carol"})
+	tree.Insert(entry{300, "alice"})
+	tree.Insert(entry{100, "bob"})
+
+	if first, _ := tree.Select(0); first.player != "alice" {
+		t.Errorf("Expected alice first, got %s", first.player)
+	}
+	if rank := tree.Rank(entry{100, "carol"}); rank != 2 {
+		t.Errorf("Expected carol at rank 2, got %d", rank)
+	}
+}
+
+func TestOrderStatisticTreeRandomOperations(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	reference := map[int]bool{}
+	rng := rand.New(rand.NewPCG(13, 13))
+
+	for range 3000 {
+		val := rng.IntN(500)
+		if rng.IntN(3) == 0 {
+			tree.Delete(val)
+			delete(reference, val)
+		} else {
+			tree.Insert(val)
+			reference[val] = true
+		}
+	}
+
+	checkSubtreeSizes(t, tree.root)
+
+	sorted := make([]int, 0, len(reference))
+	for val := range reference {
+		sorted = append(sorted, val)
+	}
+	slices.Sort(sorted)
+
+	for k, val := range sorted {
+		if got, _ := tree.Select(k); got != val {
+			t.Fatalf("Expected Select(%d) = %d, got %d", k, val, got)
+		}
+		if rank := tree.Rank(val); rank != k {
+			t.Fatalf("Expected Rank(%d) = %d, got %d", val, k, rank)
+		}
+	}
+}
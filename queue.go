@@ -44,6 +44,12 @@ func (q *Queue[T]) Next() (T, bool) {
 	return next, true
 }
 
+// Dequeue returns and removes the first element from the queue.
+// It is the same as Next and lets Queue satisfy the FIFO interface.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.Next()
+}
+
 // Peek returns the next element in the queue without removing it.
 // Returns false if there is no next element.
 func (q *Queue[T]) Peek() (T, bool) {
@@ -0,0 +1,376 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+type radixNode[V any] struct {
+	prefix   string // the edge label into this node; empty only for the root
+	value    V
+	hasValue bool
+	children []*radixNode[V] // sorted by the first byte of their prefix
+}
+
+// child returns the child whose prefix starts with b and its position, or
+// nil and the position where it would be inserted. No two children start
+// with the same byte.
+func (n *radixNode[V]) child(b byte) (*radixNode[V], int) {
+	i, found := slices.BinarySearchFunc(n.children, b, func(c *radixNode[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+	if found {
+		return n.children[i], i
+	}
+	return nil, i
+}
+
+// mergeChild folds the only child of n into n, joining their prefixes.
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.value, n.hasValue, n.children = c.value, c.hasValue, c.children
+}
+
+// commonPrefixLen returns the length of the longest common prefix of a and b.
+func commonPrefixLen[K byteKey](a string, b K) int {
+	n := min(len(a), len(b))
+	for i := range n {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// RadixTree is a Trie with path compression: a chain of nodes that holds no
+// keys and has no branches is stored as one node whose edge is labeled with
+// the whole substring. It has the same methods as Trie but uses far fewer
+// nodes when keys share long prefixes, such as URL paths or hostnames.
+// Keys are visited in lexicographic byte order. Like Trie, it has []byte
+// versions of Insert, Get, Contains, HasPrefix and LongestPrefixOf.
+type RadixTree[V any] struct {
+	root radixNode[V]
+	size int
+}
+
+// NewRadixTree creates and returns a new empty radix tree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{}
+}
+
+// Insert associates the value with the key, replacing any previous value.
+// An edge is split when the key leaves it part way along.
+// Returns true if a new key was added.
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	return radixInsert(t, key, value)
+}
+
+// InsertBytes is like Insert but takes the key as a []byte.
+func (t *RadixTree[V]) InsertBytes(key []byte, value V) bool {
+	return radixInsert(t, key, value)
+}
+
+func radixInsert[V any, K byteKey](t *RadixTree[V], key K, value V) bool {
+	node, rest := &t.root, key
+	for len(rest) > 0 {
+		child, pos := node.child(rest[0])
+		if child == nil {
+			leaf := &radixNode[V]{prefix: string(rest), value: value, hasValue: true}
+			node.children = slices.Insert(node.children, pos, leaf)
+			t.size++
+			return true
+		}
+
+		n := commonPrefixLen(child.prefix, rest)
+		if n < len(child.prefix) {
+			// Split the edge where the key leaves it
+			mid := &radixNode[V]{prefix: child.prefix[:n], children: []*radixNode[V]{child}}
+			child.prefix = child.prefix[n:]
+			node.children[pos] = mid
+			child = mid
+		}
+		node, rest = child, rest[n:]
+	}
+
+	added := !node.hasValue
+	node.value = value
+	node.hasValue = true
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// radixGet returns the value for exactly key.
+func radixGet[V any, K byteKey](t *RadixTree[V], key K) (V, bool) {
+	node, rest := &t.root, key
+	for len(rest) > 0 {
+		child, _ := node.child(rest[0])
+		if child == nil || commonPrefixLen(child.prefix, rest) < len(child.prefix) {
+			node = nil
+			break
+		}
+		node, rest = child, rest[len(child.prefix):]
+	}
+	if node != nil && node.hasValue {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Get returns the value for a key.
+// Returns false if the key is not in the tree.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	return radixGet(t, key)
+}
+
+// GetBytes is like Get but takes the key as a []byte.
+func (t *RadixTree[V]) GetBytes(key []byte) (V, bool) {
+	return radixGet(t, key)
+}
+
+// Contains checks if the key is in the tree.
+func (t *RadixTree[V]) Contains(key string) bool {
+	_, ok := radixGet(t, key)
+	return ok
+}
+
+// ContainsBytes is like Contains but takes the key as a []byte.
+func (t *RadixTree[V]) ContainsBytes(key []byte) bool {
+	_, ok := radixGet(t, key)
+	return ok
+}
+
+// Delete removes a key, merging nodes again so the tree stays compressed.
+// Returns false if the key is not in the tree.
+func (t *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	node, rest := &t.root, key
+	for rest != "" {
+		child, _ := node.child(rest[0])
+		if child == nil || !strings.HasPrefix(rest, child.prefix) {
+			return false
+		}
+		parent, node, rest = node, child, rest[len(child.prefix):]
+	}
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.value = zero
+	node.hasValue = false
+	t.size--
+
+	if node == &t.root {
+		return true
+	}
+	switch len(node.children) {
+	case 0:
+		_, pos := parent.child(node.prefix[0])
+		parent.children = slices.Delete(parent.children, pos, pos+1)
+		if parent != &t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		node.mergeChild()
+	}
+	return true
+}
+
+// radixLocate finds the node below which every key starts with prefix, and
+// how many bytes of prefix come before the edge into that node. The prefix
+// may end part way along the node's edge.
+// Returns nil if no key starts with prefix.
+func radixLocate[V any, K byteKey](t *RadixTree[V], prefix K) (*radixNode[V], int) {
+	node, consumed := &t.root, 0
+	for consumed < len(prefix) {
+		rest := prefix[consumed:]
+		child, _ := node.child(rest[0])
+		if child == nil {
+			return nil, 0
+		}
+		n := commonPrefixLen(child.prefix, rest)
+		if n < len(rest) && n < len(child.prefix) {
+			return nil, 0
+		}
+		if n == len(rest) {
+			return child, consumed
+		}
+		node, consumed = child, consumed+len(child.prefix)
+	}
+	return node, consumed - len(node.prefix)
+}
+
+// HasPrefix returns true if any key in the tree starts with prefix.
+// Every key starts with the empty prefix, so HasPrefix("") is true unless
+// the tree is empty.
+func (t *RadixTree[V]) HasPrefix(prefix string) bool {
+	return radixHasPrefix(t, prefix)
+}
+
+// HasPrefixBytes is like HasPrefix but takes the prefix as a []byte.
+func (t *RadixTree[V]) HasPrefixBytes(prefix []byte) bool {
+	return radixHasPrefix(t, prefix)
+}
+
+func radixHasPrefix[V any, K byteKey](t *RadixTree[V], prefix K) bool {
+	node, _ := radixLocate(t, prefix)
+	return node != nil && (node.hasValue || len(node.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys that start with prefix,
+// in lexicographic order. The tree must not be changed while iterating.
+func (t *RadixTree[V]) KeysWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for key := range t.walk(prefix) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values of the tree in
+// lexicographic key order. The tree must not be changed while iterating.
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return t.walk("")
+}
+
+// walk visits the keys that start with prefix in pre-order, which is
+// lexicographic order.
+func (t *RadixTree[V]) walk(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		start, before := radixLocate(t, prefix)
+		if start == nil {
+			return
+		}
+		key := prefix[:before] + start.prefix
+
+		type entry struct {
+			node *radixNode[V]
+			key  string
+		}
+		stack := []entry{{start, key}}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if current.node.hasValue && !yield(current.key, current.node.value) {
+				return
+			}
+			// Push the children in reverse so the smallest prefix comes out first
+			for i := len(current.node.children) - 1; i >= 0; i-- {
+				child := current.node.children[i]
+				stack = append(stack, entry{child, current.key + child.prefix})
+			}
+		}
+	}
+}
+
+// LongestPrefixOf returns the longest key in the tree that is a prefix of s,
+// together with its value. This is the lookup used for routing, where the
+// most specific match wins. Returns false if no key is a prefix of s.
+func (t *RadixTree[V]) LongestPrefixOf(s string) (string, V, bool) {
+	return radixLongestPrefixOf(t, s)
+}
+
+// LongestPrefixOfBytes is like LongestPrefixOf but takes s as a []byte.
+// The returned key is a slice of s, not a copy.
+func (t *RadixTree[V]) LongestPrefixOfBytes(s []byte) ([]byte, V, bool) {
+	return radixLongestPrefixOf(t, s)
+}
+
+func radixLongestPrefixOf[V any, K byteKey](t *RadixTree[V], s K) (K, V, bool) {
+	var value V
+	length, found := 0, false
+
+	node, consumed := &t.root, 0
+	for {
+		if node.hasValue {
+			value, length, found = node.value, consumed, true
+		}
+		if consumed == len(s) {
+			break
+		}
+		child, _ := node.child(s[consumed])
+		if child == nil || commonPrefixLen(child.prefix, s[consumed:]) < len(child.prefix) {
+			break
+		}
+		node, consumed = child, consumed+len(child.prefix)
+	}
+	return s[:length], value, found
+}
+
+// Keys returns all keys in lexicographic order.
+func (t *RadixTree[V]) Keys() []string {
+	keys := make([]string, 0, t.size)
+	for key := range t.walk("") {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Len returns the number of keys in the tree.
+func (t *RadixTree[V]) Len() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree has no keys.
+func (t *RadixTree[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all keys from the tree.
+func (t *RadixTree[V]) Clear() {
+	t.root = radixNode[V]{}
+	t.size = 0
+}
+
+// String returns a drawing of the tree with one line per node, showing the
+// substring on the edge into the node and the value stored there:
+//
+//	RadixTree
+//	├── api/
+//	│   ├── orders = 2
+//	│   └── users = 1
+//	└── health = 3
+func (t *RadixTree[V]) String() string {
+	if t.size == 0 {
+		return "RadixTree{empty}\n"
+	}
+
+	type entry struct {
+		node   *radixNode[V]
+		prefix string
+		last   bool
+	}
+
+	var b strings.Builder
+	b.WriteString("RadixTree" + formatTrieValue(t.root.hasValue, t.root.value) + "\n")
+
+	stack := []entry{}
+	pushChildren := func(node *radixNode[V], prefix string) {
+		for i := len(node.children) - 1; i >= 0; i-- {
+			stack = append(stack, entry{node.children[i], prefix, i == len(node.children)-1})
+		}
+	}
+	pushChildren(&t.root, "")
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		connector, indent := "├── ", "│   "
+		if current.last {
+			connector, indent = "└── ", "    "
+		}
+		label := formatTrieLabel(current.node.prefix)
+		b.WriteString(current.prefix + connector + label + formatTrieValue(current.node.hasValue, current.node.value) + "\n")
+		pushChildren(current.node, current.prefix+indent)
+	}
+	return b.String()
+}
@@ -0,0 +1,186 @@
+package collections
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkRadix verifies that the tree is fully compressed: every node other
+// than the root holds a key or branches, and children are sorted by their
+// distinct first bytes.
+func checkRadix[V any](t *testing.T, tree *RadixTree[V]) {
+	t.Helper()
+	count := 0
+	stack := []*radixNode[V]{&tree.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if node.hasValue {
+			count++
+		}
+		if node != &tree.root {
+			if node.prefix == "" {
+				t.Fatal("A node below the root has an empty prefix")
+			}
+			if !node.hasValue && len(node.children) < 2 {
+				t.Fatalf("Node %q should have been merged", node.prefix)
+			}
+		}
+		for i, child := range node.children {
+			if i > 0 && node.children[i-1].prefix[0] >= child.prefix[0] {
+				t.Fatalf("Children of %q are not sorted", node.prefix)
+			}
+			stack = append(stack, child)
+		}
+	}
+	if count != tree.size {
+		t.Fatalf("Tree holds %d keys, size is %d", count, tree.size)
+	}
+}
+
+func TestNewRadixTree(t *testing.T) {
+	tree := NewRadixTree[int]()
+	if tree == nil {
+		t.Fatal("NewRadixTree() returned nil")
+	}
+	if !tree.IsEmpty() || tree.Len() != 0 {
+		t.Error("New tree should be empty")
+	}
+	if str := tree.String(); str != "RadixTree{empty}\n" {
+		t.Errorf("Expected 'RadixTree{empty}', got %q", str)
+	}
+}
+
+func TestRadixTreeCompression(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("romane", 1)
+	tree.Insert("romanus", 2)
+	tree.Insert("romulus", 3)
+	tree.Insert("rubens", 4)
+	tree.Insert("ruber", 5)
+	tree.Insert("rubicon", 6)
+	tree.Insert("rubicundus", 7)
+	checkRadix(t, tree)
+
+	expected := strings.Join([]string{
+		"RadixTree",
+		"└── r",
+		"    ├── om",
+		"    │   ├── an",
+		"    │   │   ├── e = 1",
+		"    │   │   └── us = 2",
+		"    │   └── ulus = 3",
+		"    └── ub",
+		"        ├── e",
+		"        │   ├── ns = 4",
+		"        │   └── r = 5",
+		"        └── ic",
+		"            ├── on = 6",
+		"            └── undus = 7",
+		"",
+	}, "\n")
+	if str := tree.String(); str != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, str)
+	}
+
+	// Inserting a key that ends part way along an edge splits it
+	if !tree.Insert("rom", 8) || tree.Insert("rom", 80) {
+		t.Error("Insert should report new keys")
+	}
+	checkRadix(t, tree)
+	if v, _ := tree.Get("rom"); v != 80 {
+		t.Errorf("Expected 80, got %d", v)
+	}
+	if _, ok := tree.Get("ro"); ok {
+		t.Error("A prefix that is not a key should not be found")
+	}
+
+	// Deleting merges the nodes again
+	for _, key := range []string{"rom", "romulus", "rubens", "ruber"} {
+		if !tree.Delete(key) {
+			t.Errorf("Delete(%q) should succeed", key)
+		}
+		checkRadix(t, tree)
+	}
+	if tree.Delete("rom") || tree.Delete("rubi") || tree.Delete("zzz") {
+		t.Error("Delete of a missing key should return false")
+	}
+
+	expected = strings.Join([]string{
+		"RadixTree",
+		"└── r",
+		"    ├── oman",
+		"    │   ├── e = 1",
+		"    │   └── us = 2",
+		"    └── ubic",
+		"        ├── on = 6",
+		"        └── undus = 7",
+		"",
+	}, "\n")
+	if str := tree.String(); str != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, str)
+	}
+}
+
+func TestRadixTreePrefixes(t *testing.T) {
+	tree := NewRadixTree[string]()
+	tree.Insert("example.com", "site")
+	tree.Insert("example.com.evil", "bad")
+	tree.Insert("api.example.com", "api")
+	tree.Insert("apps.example.com", "apps")
+
+	if !tree.HasPrefix("ap") || !tree.HasPrefix("api.ex") || tree.HasPrefix("apx") || tree.HasPrefix("example.org") {
+		t.Error("HasPrefix is wrong")
+	}
+
+	tests := []struct {
+		prefix   string
+		expected []string
+	}{
+		{"a", []string{"api.example.com", "apps.example.com"}},
+		{"ap", []string{"api.example.com", "apps.example.com"}},
+		{"app", []string{"apps.example.com"}},
+		{"example.com", []string{"example.com", "example.com.evil"}},
+		{"example.com.", []string{"example.com.evil"}},
+		{"b", nil},
+		{"", []string{"api.example.com", "apps.example.com", "example.com", "example.com.evil"}},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tree.KeysWithPrefix(tt.prefix)); !slices.Equal(got, tt.expected) {
+			t.Errorf("KeysWithPrefix(%q) = %v, expected %v", tt.prefix, got, tt.expected)
+		}
+	}
+
+	if key, value, ok := tree.LongestPrefixOf("example.com.evil/path"); !ok || key != "example.com.evil" || value != "bad" {
+		t.Errorf("Unexpected match %q", key)
+	}
+	if key, _, ok := tree.LongestPrefixOf("example.co"); ok {
+		t.Errorf("Expected no match, got %q", key)
+	}
+
+	all := maps.Collect(tree.All())
+	if len(all) != 4 || all["apps.example.com"] != "apps" {
+		t.Errorf("Unexpected entries %v", all)
+	}
+	if !slices.Equal(tree.Keys(), tests[len(tests)-1].expected) {
+		t.Errorf("Unexpected keys %v", tree.Keys())
+	}
+
+	// The empty key lives at the root
+	tree.Insert("", "default")
+	if v, ok := tree.Get(""); !ok || v != "default" || !strings.HasPrefix(tree.String(), "RadixTree = default\n") {
+		t.Error("The empty key should be stored at the root")
+	}
+	if !tree.Delete("") || tree.Contains("") || tree.Len() != 4 {
+		t.Error("Deleting the empty key should keep the others")
+	}
+	checkRadix(t, tree)
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.HasPrefix("") {
+		t.Error("Tree should be empty after Clear")
+	}
+}
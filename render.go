@@ -0,0 +1,327 @@
+package collections
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The renderers below turn a Tree or LinkedList into text for debugging and
+// documentation. Each one takes a format function that converts a value to
+// its label. If format is nil, values are printed with fmt.Sprint.
+
+// missingChild is printed in place of the absent child of a node that has
+// only one child, so left and right children can be told apart.
+const missingChild = "∅"
+
+func formatter[T any](format func(T) string) func(T) string {
+	if format == nil {
+		return func(value T) string { return fmt.Sprint(value) }
+	}
+	return format
+}
+
+// RenderTreeASCII draws the tree sideways with box-drawing characters, one
+// node per line, in the style of the tree command:
+//
+//	1
+//	├── 2
+//	│   ├── 4
+//	│   └── 5
+//	└── 3
+//	    ├── ∅
+//	    └── 6
+//
+// The left child is always listed first, and ∅ marks a missing child.
+func RenderTreeASCII[T any](t *Tree[T], format func(T) string) string {
+	if t.root == nil {
+		return "(empty)\n"
+	}
+	label := formatter(format)
+
+	type entry struct {
+		node   *Node[T] // nil for a missing child
+		prefix string
+		last   bool
+	}
+
+	var b strings.Builder
+	b.WriteString(label(t.root.Value) + "\n")
+
+	stack := []entry{}
+	pushChildren := func(node *Node[T], prefix string) {
+		if node.Left == nil && node.Right == nil {
+			return
+		}
+		// Push right first so the left child is drawn first
+		stack = append(stack, entry{node.Right, prefix, true}, entry{node.Left, prefix, false})
+	}
+	pushChildren(t.root, "")
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		connector, indent := "├── ", "│   "
+		if current.last {
+			connector, indent = "└── ", "    "
+		}
+
+		if current.node == nil {
+			b.WriteString(current.prefix + connector + missingChild + "\n")
+			continue
+		}
+		b.WriteString(current.prefix + connector + label(current.node.Value) + "\n")
+		pushChildren(current.node, current.prefix+indent)
+	}
+	return b.String()
+}
+
+// RenderTreeTopDown draws the tree with the root at the top and each level
+// below its parent:
+//
+//	      1
+//	  ┌───┴─┐
+//	  2     3
+//	┌─┴─┐   └─┐
+//	4   5     6
+//
+// Every node gets its own column in in-order position, so the drawing is as
+// wide as all labels together.
+func RenderTreeTopDown[T any](t *Tree[T], format func(T) string) string {
+	if t.root == nil {
+		return "(empty)\n"
+	}
+	label := formatter(format)
+
+	type placed struct {
+		label  string
+		col    int
+		center int
+	}
+	type entry struct {
+		node  *Node[T]
+		depth int
+	}
+
+	// Assign columns with an iterative in-order walk
+	positions := map[*Node[T]]*placed{}
+	levels := [][]*Node[T]{}
+	width := 0
+	stack := []entry{}
+	current := entry{t.root, 0}
+	for current.node != nil || len(stack) > 0 {
+		for current.node != nil {
+			stack = append(stack, current)
+			current = entry{current.node.Left, current.depth + 1}
+		}
+
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		text := label(top.node.Value)
+		size := utf8.RuneCountInString(text)
+		positions[top.node] = &placed{text, width, width + (size-1)/2}
+		width += size + 1
+
+		for len(levels) <= top.depth {
+			levels = append(levels, nil)
+		}
+		levels[top.depth] = append(levels[top.depth], top.node)
+
+		current = entry{top.node.Right, top.depth + 1}
+	}
+
+	var b strings.Builder
+	writeRow := func(row []rune) {
+		b.WriteString(strings.TrimRight(string(row), " ") + "\n")
+	}
+	blankRow := func() []rune {
+		row := make([]rune, width)
+		for i := range row {
+			row[i] = ' '
+		}
+		return row
+	}
+
+	for depth, nodes := range levels {
+		row := blankRow()
+		for _, node := range nodes {
+			p := positions[node]
+			copy(row[p.col:], []rune(p.label))
+		}
+		writeRow(row)
+
+		if depth == len(levels)-1 {
+			break
+		}
+
+		row = blankRow()
+		for _, node := range nodes {
+			if node.Left == nil && node.Right == nil {
+				continue
+			}
+
+			center := positions[node].center
+			from, to := center, center
+			if node.Left != nil {
+				from = positions[node.Left].center
+			}
+			if node.Right != nil {
+				to = positions[node.Right].center
+			}
+			for i := from + 1; i < to; i++ {
+				row[i] = '─'
+			}
+
+			switch {
+			case node.Left != nil && node.Right != nil:
+				row[from], row[center], row[to] = '┌', '┴', '┐'
+			case node.Left != nil:
+				row[from], row[center] = '┌', '┘'
+			default:
+				row[center], row[to] = '└', '┐'
+			}
+		}
+		writeRow(row)
+	}
+	return b.String()
+}
+
+// treeNodeIDs numbers the nodes of the tree in level order and calls fn for
+// every node with its id and the ids of its children, -1 if missing.
+func treeNodeIDs[T any](t *Tree[T], fn func(node *Node[T], id, left, right int)) {
+	if t.root == nil {
+		return
+	}
+
+	ids := map[*Node[T]]int{t.root: 0}
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		children := [2]int{-1, -1}
+		for i, child := range []*Node[T]{current.Left, current.Right} {
+			if child != nil {
+				ids[child] = len(ids)
+				children[i] = ids[child]
+				queue.Enqueue(child)
+			}
+		}
+		fn(current, ids[current], children[0], children[1])
+	}
+}
+
+// RenderTreeDOT returns the tree as a Graphviz digraph. Nodes are named n0,
+// n1, ... in level order, and edges are labeled L or R.
+func RenderTreeDOT[T any](t *Tree[T], format func(T) string) string {
+	label := formatter(format)
+
+	var nodes, edges strings.Builder
+	treeNodeIDs(t, func(node *Node[T], id, left, right int) {
+		fmt.Fprintf(&nodes, "\tn%d [label=\"%s\"];\n", id, escapeDOT(label(node.Value)))
+		if left >= 0 {
+			fmt.Fprintf(&edges, "\tn%d -> n%d [label=\"L\"];\n", id, left)
+		}
+		if right >= 0 {
+			fmt.Fprintf(&edges, "\tn%d -> n%d [label=\"R\"];\n", id, right)
+		}
+	})
+	return "digraph Tree {\n" + nodes.String() + edges.String() + "}\n"
+}
+
+// RenderTreeMermaid returns the tree as a Mermaid flowchart. Nodes are named
+// n0, n1, ... in level order, and edges are labeled L or R.
+func RenderTreeMermaid[T any](t *Tree[T], format func(T) string) string {
+	label := formatter(format)
+
+	var nodes, edges strings.Builder
+	treeNodeIDs(t, func(node *Node[T], id, left, right int) {
+		fmt.Fprintf(&nodes, "    n%d[\"%s\"]\n", id, escapeMermaid(label(node.Value)))
+		if left >= 0 {
+			fmt.Fprintf(&edges, "    n%d -->|L| n%d\n", id, left)
+		}
+		if right >= 0 {
+			fmt.Fprintf(&edges, "    n%d -->|R| n%d\n", id, right)
+		}
+	})
+	return "flowchart TD\n" + nodes.String() + edges.String()
+}
+
+// RenderListASCII draws the list on one line. A circular list gets a second
+// line showing the link from the tail back to the head:
+//
+//	┌─> 1 -> 2 -> 3 ─┐
+//	└────────────────┘
+func RenderListASCII[T any](l *LinkedList[T], format func(T) string) string {
+	if l.head == nil {
+		return "(empty)\n"
+	}
+	label := formatter(format)
+
+	labels := make([]string, 0, l.size)
+	current := l.head
+	for range l.size {
+		labels = append(labels, label(current.Value))
+		current = current.Next
+	}
+	line := strings.Join(labels, " -> ")
+
+	if !l.circular {
+		return line + "\n"
+	}
+	line = "┌─> " + line + " ─┐"
+	return line + "\n└" + strings.Repeat("─", utf8.RuneCountInString(line)-2) + "┘\n"
+}
+
+// RenderListDOT returns the list as a left-to-right Graphviz digraph. The
+// link from the tail back to the head of a circular list is dashed.
+func RenderListDOT[T any](l *LinkedList[T], format func(T) string) string {
+	label := formatter(format)
+
+	var b strings.Builder
+	b.WriteString("digraph LinkedList {\n\trankdir=LR;\n")
+	current := l.head
+	for i := range l.size {
+		fmt.Fprintf(&b, "\tn%d [label=\"%s\"];\n", i, escapeDOT(label(current.Value)))
+		current = current.Next
+	}
+	for i := 1; i < l.size; i++ {
+		fmt.Fprintf(&b, "\tn%d -> n%d;\n", i-1, i)
+	}
+	if l.circular && l.size > 0 {
+		fmt.Fprintf(&b, "\tn%d -> n0 [style=dashed];\n", l.size-1)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// RenderListMermaid returns the list as a left-to-right Mermaid flowchart.
+// The link from the tail back to the head of a circular list is dotted.
+func RenderListMermaid[T any](l *LinkedList[T], format func(T) string) string {
+	label := formatter(format)
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	current := l.head
+	for i := range l.size {
+		fmt.Fprintf(&b, "    n%d[\"%s\"]\n", i, escapeMermaid(label(current.Value)))
+		current = current.Next
+	}
+	for i := 1; i < l.size; i++ {
+		fmt.Fprintf(&b, "    n%d --> n%d\n", i-1, i)
+	}
+	if l.circular && l.size > 0 {
+		fmt.Fprintf(&b, "    n%d -.-> n0\n", l.size-1)
+	}
+	return b.String()
+}
+
+func escapeDOT(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func escapeMermaid(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br>").Replace(s)
+}
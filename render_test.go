@@ -0,0 +1,109 @@
+package collections
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// checkGolden compares got with testdata/<name>.golden, rewriting the file
+// instead when the tests run with -update.
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Reading golden file: %v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s does not match the golden file\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestRenderTree(t *testing.T) {
+	renderers := map[string]func(*Tree[int], func(int) string) string{
+		"ascii":   RenderTreeASCII[int],
+		"topdown": RenderTreeTopDown[int],
+		"dot":     RenderTreeDOT[int],
+		"mermaid": RenderTreeMermaid[int],
+	}
+
+	trees := map[string]*Tree[int]{
+		"sample": newSampleTree(),
+		"empty":  NewTree[int](),
+	}
+	trees["single"], _ = NewTreeFromLevelOrder([]int{1}, -1)
+	trees["wide"], _ = NewBalancedBSTFromSorted([]int{1, 5, 10, 50, 100, 500, 1000})
+
+	for treeName, tree := range trees {
+		for renderName, render := range renderers {
+			checkGolden(t, "tree_"+treeName+"."+renderName, render(tree, nil))
+		}
+	}
+}
+
+func TestRenderTreeFormat(t *testing.T) {
+	tree := NewTree[string]()
+	for _, v := range []string{"root", `say "hi"`, "c"} {
+		tree.Insert(v)
+	}
+	format := func(s string) string { return strings.ToUpper(s) }
+
+	checkGolden(t, "tree_format.ascii", RenderTreeASCII(tree, format))
+	checkGolden(t, "tree_format.topdown", RenderTreeTopDown(tree, format))
+	checkGolden(t, "tree_format.dot", RenderTreeDOT(tree, format))
+	checkGolden(t, "tree_format.mermaid", RenderTreeMermaid(tree, format))
+}
+
+func TestRenderList(t *testing.T) {
+	renderers := map[string]func(*LinkedList[int], func(int) string) string{
+		"ascii":   RenderListASCII[int],
+		"dot":     RenderListDOT[int],
+		"mermaid": RenderListMermaid[int],
+	}
+
+	circular := NewCircularLinkedList[int]()
+	circular.AppendAll([]int{1, 2, 3})
+	lists := map[string]*LinkedList[int]{
+		"linear":   NewLinkedListFrom(1, 2, 3),
+		"circular": circular,
+		"empty":    NewLinkedList[int](),
+	}
+
+	for listName, list := range lists {
+		for renderName, render := range renderers {
+			checkGolden(t, "list_"+listName+"."+renderName, render(list, nil))
+		}
+	}
+}
+
+func TestRenderTreeTopDownDeep(t *testing.T) {
+	// A degenerate tree draws one row per node plus a connector row between them
+	tree := NewBST[int]()
+	for i := range 1000 {
+		tree.InsertBST(i)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(RenderTreeTopDown(tree, nil), "\n"), "\n")
+	if len(lines) != 2*1000-1 {
+		t.Errorf("Expected %d lines, got %d", 2*1000-1, len(lines))
+	}
+	if RenderTreeASCII(tree, nil) == "" {
+		t.Error("RenderTreeASCII should render a deep tree")
+	}
+}
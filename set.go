@@ -0,0 +1,224 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Set represents an unordered collection of unique values backed by a map.
+// Add, Remove and Contains run in constant time. The zero value is an
+// empty set ready to use.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates and returns a new empty set.
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{items: make(map[T]struct{})}
+}
+
+// NewSetFrom creates a set holding the given values. Duplicates are ignored.
+func NewSetFrom[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(values))}
+	for _, value := range values {
+		s.items[value] = struct{}{}
+	}
+	return s
+}
+
+// ToSet creates a set holding the values of any container, such as a
+// LinkedList, Queue or Tree. Duplicates are ignored.
+func ToSet[T comparable](c Container[T]) *Set[T] {
+	return NewSetFrom(c.ToSlice()...)
+}
+
+// CollectSet creates a set holding the values produced by seq.
+func CollectSet[T comparable](seq iter.Seq[T]) *Set[T] {
+	s := NewSet[T]()
+	for value := range seq {
+		s.items[value] = struct{}{}
+	}
+	return s
+}
+
+// Add adds a value to the set.
+// Returns false if the value is already in the set.
+func (s *Set[T]) Add(value T) bool {
+	if _, ok := s.items[value]; ok {
+		return false
+	}
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[value] = struct{}{}
+	return true
+}
+
+// AddAll adds every value to the set and returns how many were new.
+func (s *Set[T]) AddAll(values ...T) int {
+	added := 0
+	for _, value := range values {
+		if s.Add(value) {
+			added++
+		}
+	}
+	return added
+}
+
+// Remove removes a value from the set.
+// Returns false if the value is not in the set.
+func (s *Set[T]) Remove(value T) bool {
+	if _, ok := s.items[value]; !ok {
+		return false
+	}
+	delete(s.items, value)
+	return true
+}
+
+// Contains checks if the value is in the set.
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty returns true if the set has no values.
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes all values from the set.
+func (s *Set[T]) Clear() {
+	clear(s.items)
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{items: maps.Clone(s.items)}
+}
+
+// Union returns a new set with the values that are in either set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := &Set[T]{items: make(map[T]struct{}, max(s.Len(), other.Len()))}
+	maps.Copy(result.items, s.items)
+	maps.Copy(result.items, other.items)
+	return result
+}
+
+// Intersection returns a new set with the values that are in both sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	result := NewSet[T]()
+	for value := range small.items {
+		if large.Contains(value) {
+			result.items[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the values of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := NewSet[T]()
+	for value := range s.items {
+		if !other.Contains(value) {
+			result.items[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set with the values that are in
+// exactly one of the two sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for value := range other.items {
+		if !s.Contains(value) {
+			result.items[value] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset returns true if every value of s is also in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for value := range s.items {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if s holds every value of other.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint returns true if the sets have no values in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	for value := range small.items {
+		if large.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if both sets hold the same values.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// All returns an iterator over the values of the set, in no particular order.
+// The set must not be changed while iterating, except by removing the
+// current value.
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
+
+// ForEach applies a function to each value, in no particular order.
+func (s *Set[T]) ForEach(fn func(T)) {
+	for value := range s.items {
+		fn(value)
+	}
+}
+
+// ToSlice returns all values in no particular order.
+func (s *Set[T]) ToSlice() []T {
+	return slices.AppendSeq(make([]T, 0, len(s.items)), maps.Keys(s.items))
+}
+
+// String returns a string representation of the set.
+// Values are listed in no particular order.
+func (s *Set[T]) String() string {
+	if s.IsEmpty() {
+		return "Set{empty}"
+	}
+
+	result := ""
+	s.ForEach(func(value T) {
+		if result != "" {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v", value)
+	})
+	return "Set{" + result + "}"
+}
@@ -0,0 +1,228 @@
+package collections
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+// sortedSet returns the values of s in ascending order for comparisons.
+func sortedSet(s *Set[int]) []int {
+	return slices.Sorted(s.All())
+}
+
+func TestNewSet(t *testing.T) {
+	s := NewSet[int]()
+	if s == nil {
+		t.Fatal("NewSet() returned nil")
+	}
+	if !s.IsEmpty() {
+		t.Error("New set should be empty")
+	}
+	if str := s.String(); str != "Set{empty}" {
+		t.Errorf("Expected 'Set{empty}', got '%s'", str)
+	}
+
+	// The zero value is ready to use
+	var zero Set[string]
+	if zero.Contains("a") || zero.Remove("a") {
+		t.Error("Zero set should be empty")
+	}
+	if !zero.Add("a") || zero.Len() != 1 {
+		t.Error("Add on the zero set should succeed")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	s := NewSet[int]()
+	for _, val := range []int{5, 1, 3} {
+		if !s.Add(val) {
+			t.Errorf("Add(%d) should succeed", val)
+		}
+	}
+	if s.Add(3) {
+		t.Error("Add of a duplicate should return false")
+	}
+	if added := s.AddAll(3, 7, 7, 9); added != 2 {
+		t.Errorf("Expected 2 new values, got %d", added)
+	}
+
+	if s.Len() != 5 {
+		t.Errorf("Expected length 5, got %d", s.Len())
+	}
+	if !slices.Equal(sortedSet(s), []int{1, 3, 5, 7, 9}) {
+		t.Errorf("Expected [1 3 5 7 9], got %v", sortedSet(s))
+	}
+	if got := s.ToSlice(); len(got) != 5 {
+		t.Errorf("Expected 5 values, got %v", got)
+	}
+
+	if !s.Remove(3) {
+		t.Error("Remove(3) should succeed")
+	}
+	if s.Contains(3) {
+		t.Error("Set should not contain 3 after remove")
+	}
+	if s.Remove(3) {
+		t.Error("Remove of a missing value should return false")
+	}
+
+	single := NewSetFrom("x")
+	if str := single.String(); str != "Set{x}" {
+		t.Errorf("Expected 'Set{x}', got '%s'", str)
+	}
+
+	clone := s.Clone()
+	s.Clear()
+	if !s.IsEmpty() || clone.Len() != 4 {
+		t.Error("Clear should not affect a clone")
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := NewSetFrom(1, 2, 3, 4)
+	b := NewSetFrom(3, 4, 5)
+
+	tests := []struct {
+		name     string
+		got      *Set[int]
+		expected []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"Intersection", a.Intersection(b), []int{3, 4}},
+		{"Intersection reversed", b.Intersection(a), []int{3, 4}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"Difference reversed", b.Difference(a), []int{5}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"Union with empty", a.Union(NewSet[int]()), []int{1, 2, 3, 4}},
+		{"Intersection with empty", a.Intersection(NewSet[int]()), []int{}},
+	}
+	for _, tt := range tests {
+		if got := sortedSet(tt.got); !slices.Equal(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+
+	// The operands are not changed
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Error("Set operations should not modify their operands")
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	a := NewSetFrom(1, 2, 3)
+	sub := NewSetFrom(1, 3)
+	other := NewSetFrom(4, 5)
+	empty := NewSet[int]()
+
+	if !sub.IsSubset(a) || a.IsSubset(sub) {
+		t.Error("IsSubset is wrong")
+	}
+	if !a.IsSuperset(sub) || sub.IsSuperset(a) {
+		t.Error("IsSuperset is wrong")
+	}
+	if !empty.IsSubset(a) || !a.IsSubset(a) {
+		t.Error("The empty set and the set itself should be subsets")
+	}
+	if !a.IsDisjoint(other) || a.IsDisjoint(sub) || !empty.IsDisjoint(empty) {
+		t.Error("IsDisjoint is wrong")
+	}
+
+	if !a.Equal(NewSetFrom(3, 2, 1, 1)) {
+		t.Error("Sets with the same values should be equal")
+	}
+	if a.Equal(sub) || a.Equal(NewSetFrom(1, 2, 4)) {
+		t.Error("Sets with different values should not be equal")
+	}
+}
+
+func TestSetIterators(t *testing.T) {
+	s := NewSetFrom(1, 2, 3, 4)
+
+	sum := 0
+	for value := range s.All() {
+		sum += value
+	}
+	if sum != 10 {
+		t.Errorf("Expected sum 10, got %d", sum)
+	}
+
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Error("Iteration should stop on break")
+	}
+
+	// Removing the current value while iterating is allowed
+	for value := range s.All() {
+		if value%2 == 0 {
+			s.Remove(value)
+		}
+	}
+	if !slices.Equal(sortedSet(s), []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", sortedSet(s))
+	}
+
+	sum = 0
+	s.ForEach(func(value int) { sum += value })
+	if sum != 4 {
+		t.Errorf("Expected sum 4, got %d", sum)
+	}
+
+	if got := CollectSet(slices.Values([]int{2, 2, 5})); !got.Equal(NewSetFrom(2, 5)) {
+		t.Errorf("Expected Set{2, 5}, got %v", got)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	list := NewLinkedListFrom(3, 1, 3, 2)
+	if got := ToSet(list); !slices.Equal(sortedSet(got), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", sortedSet(got))
+	}
+
+	queue := NewQueue[int]()
+	queue.EnqueueAll([]int{4, 4, 5})
+	if got := ToSet(queue); !slices.Equal(sortedSet(got), []int{4, 5}) {
+		t.Errorf("Expected [4 5], got %v", sortedSet(got))
+	}
+
+	if got := ToSet(newSampleTree()); !got.Equal(NewSetFrom(newSampleTree().ToSlice()...)) {
+		t.Errorf("Expected the tree values, got %v", got)
+	}
+
+	if got := ToSet[int](NewTree[int]()); !got.IsEmpty() {
+		t.Errorf("Expected an empty set, got %v", got)
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	s := NewSetFrom(7)
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[7]" {
+		t.Errorf("Expected [7], got %s (error %v)", data, err)
+	}
+
+	var decoded Set[int]
+	if err := json.Unmarshal([]byte("[3,1,3,2]"), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(sortedSet(&decoded), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", sortedSet(&decoded))
+	}
+
+	data, _ = json.Marshal(&decoded)
+	roundTrip := NewSetFrom(99)
+	if err := json.Unmarshal(data, roundTrip); err != nil || !roundTrip.Equal(&decoded) {
+		t.Errorf("Expected %v, got %v (error %v)", &decoded, roundTrip, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":1}`), roundTrip); err == nil {
+		t.Error("Expected an error for a JSON object")
+	}
+	if roundTrip.Len() != 3 {
+		t.Error("A failed unmarshal should leave the set unchanged")
+	}
+}
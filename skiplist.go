@@ -0,0 +1,318 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+)
+
+// skipListMaxLevel is enough for 2^32 elements with a promotion probability of 1/2.
+const skipListMaxLevel = 32
+
+// skipListNode represents a node in a skip list.
+// span[i] holds the number of level 0 steps between the node and next[i].
+type skipListNode[K, V any] struct {
+	key   K
+	value V
+	next  []*skipListNode[K, V]
+	span  []int
+}
+
+// SkipList represents a probabilistic ordered map.
+// Lookups, insertions, deletions and rank queries take O(log n) on average.
+type SkipList[K, V any] struct {
+	head    *skipListNode[K, V]
+	level   int
+	size    int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+// NewSkipList creates and returns a new empty skip list ordered by the natural order of K.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListFunc[K, V](cmp.Compare[K])
+}
+
+// NewSkipListFunc creates and returns a new empty skip list ordered by compare.
+// compare must return a negative number when a < b, zero when a == b and a
+// positive number when a > b.
+func NewSkipListFunc[K, V any](compare func(a, b K) int) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:    newSkipListNode[K, V](skipListMaxLevel),
+		level:   1,
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+}
+
+func newSkipListNode[K, V any](level int) *skipListNode[K, V] {
+	return &skipListNode[K, V]{
+		next: make([]*skipListNode[K, V], level),
+		span: make([]int, level),
+	}
+}
+
+// Seed resets the random number generator used to pick node levels.
+// Lists seeded with the same value and given the same operations have the same shape.
+func (s *SkipList[K, V]) Seed(seed uint64) {
+	s.rng = rand.New(rand.NewPCG(seed, seed))
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.rng.Uint32()&1 == 1 {
+		level++
+	}
+	return level
+}
+
+// Insert adds a key with its value to the list.
+// If the key already exists, its value is replaced.
+// Returns true if a new key was added.
+func (s *SkipList[K, V]) Insert(key K, value V) bool {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	var rank [skipListMaxLevel]int
+
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for current.next[i] != nil && s.compare(current.next[i].key, key) < 0 {
+			rank[i] += current.span[i]
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+
+	if next := current.next[0]; next != nil && s.compare(next.key, key) == 0 {
+		next.value = value
+		return false
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].span[i] = s.size
+		}
+		s.level = level
+	}
+
+	newNode := newSkipListNode[K, V](level)
+	newNode.key = key
+	newNode.value = value
+	for i := range level {
+		newNode.next[i] = update[i].next[i]
+		update[i].next[i] = newNode
+		newNode.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	// Levels above the new node now skip over one more element
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.size++
+	return true
+}
+
+// Get returns the value stored for the key.
+// Returns false if the key is not in the list.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	var zero V
+
+	current := s.lowerBound(key)
+	if current == nil || s.compare(current.key, key) != 0 {
+		return zero, false
+	}
+	return current.value, true
+}
+
+// Contains checks if the key is in the list.
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Delete removes the key and its value from the list.
+// Returns true if the key was removed, false if it was not found.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [skipListMaxLevel]*skipListNode[K, V]
+
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && s.compare(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+
+	target := current.next[0]
+	if target == nil || s.compare(target.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == target {
+			update[i].span[i] += target.span[i] - 1
+			update[i].next[i] = target.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.size--
+	return true
+}
+
+// lowerBound returns the first node with a key greater than or equal to key,
+// or nil if there is none.
+func (s *SkipList[K, V]) lowerBound(key K) *skipListNode[K, V] {
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && s.compare(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+	}
+	return current.next[0]
+}
+
+// Floor returns the greatest key less than or equal to key, with its value.
+// Returns false if there is no such key.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	var zeroK K
+	var zeroV V
+
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && s.compare(current.next[i].key, key) <= 0 {
+			current = current.next[i]
+		}
+	}
+
+	if current == s.head {
+		return zeroK, zeroV, false
+	}
+	return current.key, current.value, true
+}
+
+// Ceiling returns the smallest key greater than or equal to key, with its value.
+// Returns false if there is no such key.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	var zeroK K
+	var zeroV V
+
+	current := s.lowerBound(key)
+	if current == nil {
+		return zeroK, zeroV, false
+	}
+	return current.key, current.value, true
+}
+
+// Rank returns the number of keys in the list that are less than key.
+// If key is in the list, this is its zero-based position.
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && s.compare(current.next[i].key, key) < 0 {
+			rank += current.span[i]
+			current = current.next[i]
+		}
+	}
+	return rank
+}
+
+// GetByRank returns the key and value at the given zero-based position.
+// Returns false if the rank is out of bounds.
+func (s *SkipList[K, V]) GetByRank(rank int) (K, V, bool) {
+	var zeroK K
+	var zeroV V
+
+	if rank < 0 || rank >= s.size {
+		return zeroK, zeroV, false
+	}
+
+	traversed := 0
+	current := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && traversed+current.span[i] <= rank+1 {
+			traversed += current.span[i]
+			current = current.next[i]
+		}
+		if traversed == rank+1 {
+			return current.key, current.value, true
+		}
+	}
+	return zeroK, zeroV, false
+}
+
+// Range calls fn for each key in [from, to) in ascending order.
+// Iteration stops early if fn returns false.
+func (s *SkipList[K, V]) Range(from, to K, fn func(K, V) bool) {
+	for current := s.lowerBound(from); current != nil; current = current.next[0] {
+		if s.compare(current.key, to) >= 0 {
+			return
+		}
+		if !fn(current.key, current.value) {
+			return
+		}
+	}
+}
+
+// ForEach applies a function to each key and value in ascending key order.
+func (s *SkipList[K, V]) ForEach(fn func(K, V)) {
+	for current := s.head.next[0]; current != nil; current = current.next[0] {
+		fn(current.key, current.value)
+	}
+}
+
+// Keys returns all keys in ascending order.
+func (s *SkipList[K, V]) Keys() []K {
+	result := make([]K, 0, s.size)
+	s.ForEach(func(key K, _ V) {
+		result = append(result, key)
+	})
+	return result
+}
+
+// Len returns the number of keys in the list.
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+// IsEmpty returns true if the list has no keys.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Clear removes all keys from the list.
+func (s *SkipList[K, V]) Clear() {
+	s.head = newSkipListNode[K, V](skipListMaxLevel)
+	s.level = 1
+	s.size = 0
+}
+
+// String returns a string representation of the skip list.
+func (s *SkipList[K, V]) String() string {
+	if s.size == 0 {
+		return "SkipList{empty}"
+	}
+
+	result := "SkipList{"
+	for current := s.head.next[0]; current != nil; current = current.next[0] {
+		result += fmt.Sprintf("%v: %v", current.key, current.value)
+		if current.next[0] != nil {
+			result += ", "
+		}
+	}
+	result += "}"
+	return result
+}
@@ -0,0 +1,250 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewSkipList(t *testing.T) {
+	s := NewSkipList[int, string]()
+	if s == nil {
+		t.Fatal("NewSkipList() returned nil")
+	}
+	if !s.IsEmpty() {
+		t.Error("New skip list should be empty")
+	}
+	if s.Len() != 0 {
+		t.Errorf("Expected length 0, got %d", s.Len())
+	}
+}
+
+func TestSkipListInsertGet(t *testing.T) {
+	s := NewSkipList[int, string]()
+	s.Seed(1)
+
+	if !s.Insert(2, "two") {
+		t.Error("Insert of a new key should return true")
+	}
+	s.Insert(1, "one")
+	s.Insert(3, "three")
+
+	if s.Insert(2, "TWO") {
+		t.Error("Insert of an existing key should return false")
+	}
+	if s.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", s.Len())
+	}
+
+	if val, ok := s.Get(2); !ok || val != "TWO" {
+		t.Errorf("Expected 'TWO', got '%s'", val)
+	}
+	if _, ok := s.Get(4); ok {
+		t.Error("Get of a missing key should fail")
+	}
+	if !s.Contains(3) {
+		t.Error("Skip list should contain 3")
+	}
+
+	expected := []int{1, 2, 3}
+	keys := s.Keys()
+	for i, val := range expected {
+		if keys[i] != val {
+			t.Errorf("Expected keys[%d] = %d, got %d", i, val, keys[i])
+		}
+	}
+}
+
+func TestSkipListDelete(t *testing.T) {
+	s := NewSkipList[int, int]()
+	s.Seed(2)
+	for i := range 10 {
+		s.Insert(i, i*i)
+	}
+
+	if !s.Delete(5) {
+		t.Error("Delete of an existing key should return true")
+	}
+	if s.Delete(5) {
+		t.Error("Delete of a missing key should return false")
+	}
+	if s.Contains(5) {
+		t.Error("Skip list should not contain 5 after delete")
+	}
+	if s.Len() != 9 {
+		t.Errorf("Expected length 9, got %d", s.Len())
+	}
+}
+
+func TestSkipListFloorCeiling(t *testing.T) {
+	s := NewSkipList[int, string]()
+	s.Seed(3)
+	s.Insert(10, "ten")
+	s.Insert(20, "twenty")
+	s.Insert(30, "thirty")
+
+	if key, _, ok := s.Floor(25); !ok || key != 20 {
+		t.Errorf("Expected floor 20, got %d", key)
+	}
+	if key, _, ok := s.Floor(20); !ok || key != 20 {
+		t.Errorf("Expected floor 20, got %d", key)
+	}
+	if _, _, ok := s.Floor(5); ok {
+		t.Error("Floor below the smallest key should fail")
+	}
+
+	if key, val, ok := s.Ceiling(25); !ok || key != 30 || val != "thirty" {
+		t.Errorf("Expected ceiling 30, got %d", key)
+	}
+	if _, _, ok := s.Ceiling(35); ok {
+		t.Error("Ceiling above the largest key should fail")
+	}
+}
+
+func TestSkipListRange(t *testing.T) {
+	s := NewSkipList[int, int]()
+	s.Seed(4)
+	for i := range 10 {
+		s.Insert(i, i)
+	}
+
+	keys := []int{}
+	s.Range(3, 7, func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	expected := []int{3, 4, 5, 6}
+	if len(keys) != len(expected) {
+		t.Fatalf("Expected %d keys, got %d", len(expected), len(keys))
+	}
+	for i, val := range expected {
+		if keys[i] != val {
+			t.Errorf("Expected keys[%d] = %d, got %d", i, val, keys[i])
+		}
+	}
+
+	count := 0
+	s.Range(0, 10, func(_, _ int) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Expected iteration to stop after 2 keys, got %d", count)
+	}
+}
+
+func TestSkipListRank(t *testing.T) {
+	s := NewSkipList[int, int]()
+	s.Seed(5)
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		s.Insert(key, key)
+	}
+
+	if rank := s.Rank(30); rank != 2 {
+		t.Errorf("Expected rank 2, got %d", rank)
+	}
+	if rank := s.Rank(35); rank != 3 {
+		t.Errorf("Expected rank 3, got %d", rank)
+	}
+	if key, _, ok := s.GetByRank(4); !ok || key != 50 {
+		t.Errorf("Expected key 50 at rank 4, got %d", key)
+	}
+	if _, _, ok := s.GetByRank(5); ok {
+		t.Error("GetByRank out of bounds should fail")
+	}
+}
+
+func TestSkipListRandomOperations(t *testing.T) {
+	s := NewSkipList[int, int]()
+	s.Seed(42)
+	rng := rand.New(rand.NewPCG(42, 42))
+	reference := map[int]int{}
+
+	for range 2000 {
+		key := rng.IntN(200)
+		if rng.IntN(3) == 0 {
+			_, exists := reference[key]
+			if s.Delete(key) != exists {
+				t.Fatalf("Delete(%d) disagreed with reference", key)
+			}
+			delete(reference, key)
+		} else {
+			s.Insert(key, key*2)
+			reference[key] = key * 2
+		}
+	}
+
+	if s.Len() != len(reference) {
+		t.Fatalf("Expected length %d, got %d", len(reference), s.Len())
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for i, key := range keys {
+		if rank := s.Rank(key); rank != i {
+			t.Errorf("Expected rank %d for key %d, got %d", i, key, rank)
+		}
+		if got, val, ok := s.GetByRank(i); !ok || got != key || val != key*2 {
+			t.Errorf("Expected key %d at rank %d, got %d", key, i, got)
+		}
+	}
+}
+
+func TestSkipListSeedIsDeterministic(t *testing.T) {
+	a := NewSkipList[int, int]()
+	b := NewSkipList[int, int]()
+	a.Seed(7)
+	b.Seed(7)
+	for i := range 100 {
+		a.Insert(i, i)
+		b.Insert(i, i)
+	}
+
+	if a.level != b.level {
+		t.Errorf("Expected same level for same seed, got %d and %d", a.level, b.level)
+	}
+}
+
+func TestSkipListWithComparator(t *testing.T) {
+	// Case-insensitive keys
+	s := NewSkipListFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	s.Insert("Banana", 1)
+	s.Insert("apple", 2)
+	s.Insert("APPLE", 3)
+
+	if s.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", s.Len())
+	}
+	if val, _ := s.Get("Apple"); val != 3 {
+		t.Errorf("Expected 3, got %d", val)
+	}
+}
+
+func TestSkipListClearAndString(t *testing.T) {
+	s := NewSkipList[int, string]()
+	if str := s.String(); str != "SkipList{empty}" {
+		t.Errorf("Expected 'SkipList{empty}', got '%s'", str)
+	}
+
+	s.Insert(2, "b")
+	s.Insert(1, "a")
+	if str := s.String(); str != "SkipList{1: a, 2: b}" {
+		t.Errorf("Expected 'SkipList{1: a, 2: b}', got '%s'", str)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("Skip list should be empty after Clear()")
+	}
+	if _, ok := s.Get(1); ok {
+		t.Error("Get should fail after Clear()")
+	}
+}
@@ -0,0 +1,828 @@
+package collections
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+type streamKind int
+
+const (
+	streamBinary streamKind = iota
+	streamNDJSON
+	streamCSV
+)
+
+// StreamFormat selects how WriteStream and ReadStream store values.
+// The zero value is the binary format with DefaultCodec.
+type StreamFormat[T any] struct {
+	kind       streamKind
+	codec      Codec[T]
+	toRecord   func(T) ([]string, error)
+	fromRecord func([]string) (T, error)
+}
+
+// BinaryStream returns the binary format written by MarshalBinary, storing
+// each value with codec, or DefaultCodec if codec is nil. It keeps the
+// capacity of a bounded queue, the circular flag of a list and the shape of a tree.
+func BinaryStream[T any](codec Codec[T]) StreamFormat[T] {
+	return StreamFormat[T]{kind: streamBinary, codec: codec}
+}
+
+// NDJSONStream returns a format that writes one JSON value per line.
+// Only the values are stored: reading keeps the capacity or circular flag
+// the container already has, and a Tree is refilled in level order.
+func NDJSONStream[T any]() StreamFormat[T] {
+	return StreamFormat[T]{kind: streamNDJSON}
+}
+
+// CSVStream returns a format that writes one CSV record per value, using
+// toRecord and fromRecord to convert between values and records.
+// Like NDJSONStream, only the values are stored.
+func CSVStream[T any](toRecord func(T) ([]string, error), fromRecord func([]string) (T, error)) StreamFormat[T] {
+	return StreamFormat[T]{kind: streamCSV, toRecord: toRecord, fromRecord: fromRecord}
+}
+
+// Entry is one key/value pair of a TreeMap or SkipList. It is the value
+// type of their stream formats, and encodes to JSON as
+// {"key": k, "value": v}.
+type Entry[K, V any] struct {
+	// Key is the key of the pair.
+	Key K `json:"key"`
+
+	// Value is the value stored under Key.
+	Value V `json:"value"`
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// streamEncoder writes values one at a time in a StreamFormat.
+type streamEncoder[T any] struct {
+	format StreamFormat[T]
+	out    *bufio.Writer
+	json   *json.Encoder
+	csv    *csv.Writer
+	size   [binary.MaxVarintLen64]byte
+}
+
+func newStreamEncoder[T any](w io.Writer, format StreamFormat[T]) *streamEncoder[T] {
+	e := &streamEncoder[T]{format: format, out: bufio.NewWriter(w)}
+	switch format.kind {
+	case streamNDJSON:
+		e.json = json.NewEncoder(e.out)
+	case streamCSV:
+		e.csv = csv.NewWriter(e.out)
+	default:
+		e.format.codec = orDefault(format.codec)
+	}
+	return e
+}
+
+func (e *streamEncoder[T]) write(value T) error {
+	switch e.format.kind {
+	case streamNDJSON:
+		return e.json.Encode(value)
+	case streamCSV:
+		record, err := e.format.toRecord(value)
+		if err != nil {
+			return err
+		}
+		return e.csv.Write(record)
+	}
+
+	encoded, err := e.format.codec.Encode(value)
+	if err != nil {
+		return err
+	}
+	n := binary.PutUvarint(e.size[:], uint64(len(encoded)))
+	if _, err := e.out.Write(e.size[:n]); err != nil {
+		return err
+	}
+	_, err = e.out.Write(encoded)
+	return err
+}
+
+func (e *streamEncoder[T]) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return e.out.Flush()
+}
+
+// streamDecoder reads values one at a time in a StreamFormat.
+// next returns io.EOF once an NDJSON or CSV stream ends cleanly.
+type streamDecoder[T any] struct {
+	format StreamFormat[T]
+	in     *bufio.Reader
+	json   *json.Decoder
+	csv    *csv.Reader
+}
+
+func newStreamDecoder[T any](r io.Reader, format StreamFormat[T]) *streamDecoder[T] {
+	d := &streamDecoder[T]{format: format, in: bufio.NewReader(r)}
+	switch format.kind {
+	case streamNDJSON:
+		d.json = json.NewDecoder(d.in)
+	case streamCSV:
+		d.csv = csv.NewReader(d.in)
+	default:
+		d.format.codec = orDefault(format.codec)
+	}
+	return d
+}
+
+func (d *streamDecoder[T]) next() (T, error) {
+	var value T
+	switch d.format.kind {
+	case streamNDJSON:
+		err := d.json.Decode(&value)
+		return value, err
+	case streamCSV:
+		record, err := d.csv.Read()
+		if err != nil {
+			return value, err
+		}
+		return d.format.fromRecord(record)
+	}
+
+	size, err := readStreamUvarint(d.in)
+	if err != nil {
+		return value, err
+	}
+	encoded, err := readStreamBytes(d.in, size)
+	if err != nil {
+		return value, err
+	}
+	return d.format.codec.Decode(encoded)
+}
+
+// streamReadError reports input that ends in the middle of the binary format.
+func streamReadError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("%w: %w", ErrInvalidEncoding, io.ErrUnexpectedEOF)
+	}
+	return err
+}
+
+// readStreamHeader checks the magic bytes, version and kind and returns the
+// flags. Flags outside allowed are rejected.
+func readStreamHeader(in *bufio.Reader, kind, allowed byte) (byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(in, header[:]); err != nil {
+		return 0, streamReadError(err)
+	}
+	if string(header[:2]) != binaryMagic {
+		return 0, fmt.Errorf("%w: missing header", ErrInvalidEncoding)
+	}
+	if header[2] != binaryVersion {
+		return 0, fmt.Errorf("%w: unsupported version %d", ErrInvalidEncoding, header[2])
+	}
+	if header[3] != kind {
+		return 0, fmt.Errorf("%w: expected container kind %d, got %d", ErrInvalidEncoding, kind, header[3])
+	}
+	if header[4]&^allowed != 0 {
+		return 0, fmt.Errorf("%w: unknown flags %#x", ErrInvalidEncoding, header[4])
+	}
+	return header[4], nil
+}
+
+func readStreamUvarint(in *bufio.Reader) (uint64, error) {
+	n, err := binary.ReadUvarint(in)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return 0, streamReadError(err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	return n, nil
+}
+
+// readStreamCount reads a number of values, small enough that a tree shape
+// bitmap for it can be sized without overflow.
+func readStreamCount(in *bufio.Reader) (int, error) {
+	n, err := readStreamUvarint(in)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt32 {
+		return 0, fmt.Errorf("%w: count %d is too large", ErrInvalidEncoding, n)
+	}
+	return int(n), nil
+}
+
+// readStreamBytes reads exactly n bytes. The buffer grows as data arrives,
+// so a corrupt length cannot allocate more memory than the input holds.
+func readStreamBytes(in *bufio.Reader, n uint64) ([]byte, error) {
+	if n > math.MaxInt64 {
+		return nil, fmt.Errorf("%w: length %d is too large", ErrInvalidEncoding, n)
+	}
+	data, err := io.ReadAll(io.LimitReader(in, int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != n {
+		return nil, streamReadError(io.ErrUnexpectedEOF)
+	}
+	return data, nil
+}
+
+// writeStream writes the result of prefix when the format is binary, then
+// every value produced by each. Returns the number of bytes written to w.
+func writeStream[T any](w io.Writer, format StreamFormat[T], prefix func() ([]byte, error), each func(yield func(T) bool)) (int64, error) {
+	counter := &countingWriter{w: w}
+	e := newStreamEncoder(counter, format)
+
+	if format.kind == streamBinary {
+		header, err := prefix()
+		if err != nil {
+			return 0, err
+		}
+		if _, err := e.out.Write(header); err != nil {
+			return counter.n, err
+		}
+	}
+
+	var err error
+	each(func(value T) bool {
+		err = e.write(value)
+		return err == nil
+	})
+	if err == nil {
+		err = e.flush()
+	}
+	return counter.n, err
+}
+
+// readStream reads values from r and passes each one to add. For the binary
+// format, prefix first reads everything before the values and returns how
+// many follow, and the input must end after the last one.
+// Returns the number of bytes read from r.
+func readStream[T any](r io.Reader, format StreamFormat[T], prefix func(*bufio.Reader) (int, error), add func(T) error) (int64, error) {
+	counter := &countingReader{r: r}
+	d := newStreamDecoder(counter, format)
+
+	if format.kind != streamBinary {
+		for {
+			value, err := d.next()
+			if err == io.EOF {
+				return counter.n, nil
+			}
+			if err != nil {
+				return counter.n, err
+			}
+			if err := add(value); err != nil {
+				return counter.n, err
+			}
+		}
+	}
+
+	n, err := prefix(d.in)
+	if err != nil {
+		return counter.n, err
+	}
+	for range n {
+		value, err := d.next()
+		if err != nil {
+			return counter.n, err
+		}
+		if err := add(value); err != nil {
+			return counter.n, err
+		}
+	}
+	if _, err := d.in.ReadByte(); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("%w: unexpected data after the last value", ErrInvalidEncoding)
+		}
+		return counter.n, err
+	}
+	return counter.n, nil
+}
+
+// valuesPrefix is the binary prefix of containers that only store values.
+func valuesPrefix(kind byte, count int) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		buf := appendBinaryHeader(nil, kind, 0)
+		return binary.AppendUvarint(buf, uint64(count)), nil
+	}
+}
+
+func readValuesPrefix(kind byte) func(*bufio.Reader) (int, error) {
+	return func(in *bufio.Reader) (int, error) {
+		if _, err := readStreamHeader(in, kind, 0); err != nil {
+			return 0, err
+		}
+		return readStreamCount(in)
+	}
+}
+
+// WriteStream writes the queue to w in the given format, one value at a
+// time. Returns the number of bytes written.
+func (q *Queue[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	prefix := func() ([]byte, error) {
+		buf := appendBinaryHeader(nil, binaryKindQueue, 0)
+		buf = binary.AppendUvarint(buf, uint64(q.capacity))
+		return binary.AppendUvarint(buf, uint64(q.len)), nil
+	}
+	return writeStream(w, format, prefix, func(yield func(T) bool) {
+		for _, value := range q.elements[:q.len] {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// ReadStream replaces the contents of the queue with values read from r in
+// the given format until the end of the input. Returns the number of bytes
+// read. The queue is left unchanged if reading fails.
+func (q *Queue[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	fresh := &Queue[T]{capacity: q.capacity}
+	prefix := func(in *bufio.Reader) (int, error) {
+		if _, err := readStreamHeader(in, binaryKindQueue, 0); err != nil {
+			return 0, err
+		}
+		capacity, err := readStreamUvarint(in)
+		if err != nil {
+			return 0, err
+		}
+		count, err := readStreamCount(in)
+		if err != nil {
+			return 0, err
+		}
+		if capacity > math.MaxInt || (capacity > 0 && uint64(count) > capacity) {
+			return 0, fmt.Errorf("%w: %d values do not fit capacity %d", ErrInvalidEncoding, count, capacity)
+		}
+		fresh.capacity = int(capacity)
+		return count, nil
+	}
+
+	n, err := readStream(r, format, prefix, func(value T) error {
+		if !fresh.Enqueue(value) {
+			return fmt.Errorf("collections: queue is full at capacity %d", fresh.capacity)
+		}
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*q = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (q *Queue[T]) WriteTo(w io.Writer) (int64, error) {
+	return q.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (q *Queue[T]) ReadFrom(r io.Reader) (int64, error) {
+	return q.ReadStream(r, BinaryStream[T](nil))
+}
+
+// WriteStream writes the list to w in the given format, one value at a
+// time. Returns the number of bytes written.
+func (l *LinkedList[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	prefix := func() ([]byte, error) {
+		var flags byte
+		if l.circular {
+			flags |= binaryFlagCircular
+		}
+		buf := appendBinaryHeader(nil, binaryKindList, flags)
+		return binary.AppendUvarint(buf, uint64(l.size)), nil
+	}
+	return writeStream(w, format, prefix, func(yield func(T) bool) {
+		current := l.head
+		for range l.size {
+			if !yield(current.Value) {
+				return
+			}
+			current = current.Next
+		}
+	})
+}
+
+// ReadStream replaces the contents of the list with values read from r in
+// the given format until the end of the input. Returns the number of bytes
+// read. The list is left unchanged if reading fails.
+func (l *LinkedList[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	fresh := &LinkedList[T]{circular: l.circular}
+	prefix := func(in *bufio.Reader) (int, error) {
+		flags, err := readStreamHeader(in, binaryKindList, binaryFlagCircular)
+		if err != nil {
+			return 0, err
+		}
+		fresh.circular = flags&binaryFlagCircular != 0
+		return readStreamCount(in)
+	}
+
+	n, err := readStream(r, format, prefix, func(value T) error {
+		fresh.Append(value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*l = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (l *LinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return l.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (l *LinkedList[T]) ReadFrom(r io.Reader) (int64, error) {
+	return l.ReadStream(r, BinaryStream[T](nil))
+}
+
+// WriteStream writes the tree to w in the given format, one value at a
+// time. The binary format stores the node count and a shape bitmap with
+// two bits per node in pre-order (has left child, has right child),
+// followed by the values in pre-order. NDJSON and CSV store the values in
+// level order. Returns the number of bytes written.
+func (t *Tree[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	if format.kind != streamBinary {
+		return writeStream(w, format, nil, t.WalkLevelOrder)
+	}
+
+	prefix := func() ([]byte, error) {
+		buf := appendBinaryHeader(nil, binaryKindTree, 0)
+		buf = binary.AppendUvarint(buf, uint64(t.size))
+
+		shape := make([]byte, (2*t.size+7)/8)
+		bit := 0
+		walkNodes(t.root, func(node *Node[T]) {
+			if bit/8 < len(shape) {
+				if node.Left != nil {
+					shape[bit/8] |= 1 << (bit % 8)
+				}
+				if node.Right != nil {
+					shape[bit/8] |= 2 << (bit % 8)
+				}
+			}
+			bit += 2
+		})
+		if bit != 2*t.size {
+			return nil, fmt.Errorf("collections: tree has %d nodes but size %d", bit/2, t.size)
+		}
+		return append(buf, shape...), nil
+	}
+	return writeStream(w, format, prefix, t.WalkPreOrder)
+}
+
+// ReadStream replaces the contents of the tree with values read from r in
+// the given format until the end of the input. Values read from NDJSON or
+// CSV are added with InsertBST if the tree has a compare function and with
+// Insert otherwise. The compare function is kept. Returns the number of
+// bytes read. The tree is left unchanged if reading fails.
+func (t *Tree[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	fresh := &Tree[T]{compare: t.compare}
+	add := func(value T) error {
+		if fresh.compare != nil {
+			fresh.InsertBST(value)
+		} else {
+			fresh.Insert(value)
+		}
+		return nil
+	}
+
+	// The binary format rebuilds the shape in pre-order: each value fills the
+	// next open child slot and opens slots for the children its bits announce
+	var shape []byte
+	slots := []**Node[T]{&fresh.root}
+	prefix := func(in *bufio.Reader) (int, error) {
+		if _, err := readStreamHeader(in, binaryKindTree, 0); err != nil {
+			return 0, err
+		}
+		count, err := readStreamCount(in)
+		if err != nil {
+			return 0, err
+		}
+		if shape, err = readStreamBytes(in, uint64(2*count+7)/8); err != nil {
+			return 0, err
+		}
+		if count%4 != 0 && shape[len(shape)-1]>>(2*(count%4)) != 0 {
+			return 0, fmt.Errorf("%w: shape bitmap has padding bits set", ErrInvalidEncoding)
+		}
+		if count == 0 {
+			slots = nil
+		}
+		return count, nil
+	}
+	if format.kind == streamBinary {
+		add = func(value T) error {
+			if len(slots) == 0 {
+				return fmt.Errorf("%w: shape bitmap has more nodes than child slots", ErrInvalidEncoding)
+			}
+			slot := slots[len(slots)-1]
+			slots = slots[:len(slots)-1]
+			node := NewNode(value)
+			*slot = node
+
+			i := fresh.size
+			bits := shape[2*i/8] >> (2 * i % 8)
+			if bits&2 != 0 {
+				slots = append(slots, &node.Right)
+			}
+			if bits&1 != 0 {
+				slots = append(slots, &node.Left)
+			}
+			fresh.size++
+			return nil
+		}
+	}
+
+	n, err := readStream(r, format, prefix, add)
+	if err != nil {
+		return n, err
+	}
+	if format.kind == streamBinary && len(slots) != 0 {
+		return n, fmt.Errorf("%w: shape bitmap announces %d missing nodes", ErrInvalidEncoding, len(slots))
+	}
+	*t = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (t *Tree[T]) WriteTo(w io.Writer) (int64, error) {
+	return t.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (t *Tree[T]) ReadFrom(r io.Reader) (int64, error) {
+	return t.ReadStream(r, BinaryStream[T](nil))
+}
+
+// WriteStream writes the list to w in the given format, one value at a
+// time. The node capacity is not stored. Returns the number of bytes written.
+func (l *UnrolledList[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	return writeStream(w, format, valuesPrefix(binaryKindUnrolled, l.size), func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			for _, value := range current.values {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// ReadStream replaces the contents of the list with values read from r in
+// the given format until the end of the input. The node capacity is kept.
+// Returns the number of bytes read. The list is left unchanged if reading fails.
+func (l *UnrolledList[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	capacity := l.nodeCapacity
+	if capacity == 0 {
+		capacity = defaultUnrolledNodeCapacity
+	}
+	fresh := NewUnrolledListSize[T](capacity)
+
+	n, err := readStream(r, format, readValuesPrefix(binaryKindUnrolled), func(value T) error {
+		fresh.Append(value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*l = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (l *UnrolledList[T]) WriteTo(w io.Writer) (int64, error) {
+	return l.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (l *UnrolledList[T]) ReadFrom(r io.Reader) (int64, error) {
+	return l.ReadStream(r, BinaryStream[T](nil))
+}
+
+// WriteStream writes the values of the tree to w in ascending order in the
+// given format, one value at a time. Returns the number of bytes written.
+func (t *AVLTree[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	return writeStream(w, format, valuesPrefix(binaryKindSorted, t.size), func(yield func(T) bool) {
+		stack := []*avlNode[T]{}
+		current := t.root
+		for current != nil || len(stack) > 0 {
+			for current != nil {
+				stack = append(stack, current)
+				current = current.left
+			}
+			current = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(current.value) {
+				return
+			}
+			current = current.right
+		}
+	})
+}
+
+// ReadStream replaces the contents of the tree with values read from r in
+// the given format until the end of the input, in any order. Duplicates are
+// ignored. The tree must have been created with NewAVLTree or NewAVLTreeFunc.
+// Returns the number of bytes read. The tree is left unchanged if reading fails.
+func (t *AVLTree[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	if t.compare == nil {
+		return 0, fmt.Errorf("collections: cannot read into %T without a compare function", t)
+	}
+
+	fresh := &AVLTree[T]{compare: t.compare}
+	n, err := readStream(r, format, readValuesPrefix(binaryKindSorted), func(value T) error {
+		fresh.Insert(value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*t = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (t *AVLTree[T]) WriteTo(w io.Writer) (int64, error) {
+	return t.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (t *AVLTree[T]) ReadFrom(r io.Reader) (int64, error) {
+	return t.ReadStream(r, BinaryStream[T](nil))
+}
+
+// WriteStream writes the values of the set to w in ascending order in the
+// given format, one value at a time. Returns the number of bytes written.
+func (s *TreeSet[T]) WriteStream(w io.Writer, format StreamFormat[T]) (int64, error) {
+	return writeStream(w, format, valuesPrefix(binaryKindSorted, s.Len()), func(yield func(T) bool) {
+		s.m.ascend(s.m.root, nil, nil, func(value T, _ struct{}) bool {
+			return yield(value)
+		})
+	})
+}
+
+// ReadStream replaces the contents of the set with values read from r in
+// the given format until the end of the input, in any order. Duplicates are
+// ignored. The set must have been created with NewTreeSet or NewTreeSetFunc.
+// Returns the number of bytes read. The set is left unchanged if reading fails.
+func (s *TreeSet[T]) ReadStream(r io.Reader, format StreamFormat[T]) (int64, error) {
+	if s.m == nil {
+		return 0, fmt.Errorf("collections: cannot read into %T without a compare function", s)
+	}
+
+	fresh := NewTreeSetFunc(s.m.compare)
+	n, err := readStream(r, format, readValuesPrefix(binaryKindSorted), func(value T) error {
+		fresh.Add(value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	s.m = fresh.m
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec.
+func (s *TreeSet[T]) WriteTo(w io.Writer) (int64, error) {
+	return s.WriteStream(w, BinaryStream[T](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with DefaultCodec.
+func (s *TreeSet[T]) ReadFrom(r io.Reader) (int64, error) {
+	return s.ReadStream(r, BinaryStream[T](nil))
+}
+
+// entryFormat replaces the nil codec of a binary format with EntryCodec, so
+// keys and values each use DefaultCodec instead of gob for the whole pair.
+func entryFormat[K, V any](format StreamFormat[Entry[K, V]]) StreamFormat[Entry[K, V]] {
+	if format.kind == streamBinary && format.codec == nil {
+		format.codec = EntryCodec[K, V](nil, nil)
+	}
+	return format
+}
+
+// WriteStream writes the entries of the map to w in ascending key order in
+// the given format, one entry at a time. A binary format with a nil codec
+// uses EntryCodec with DefaultCodec for keys and values. Returns the number
+// of bytes written.
+func (m *TreeMap[K, V]) WriteStream(w io.Writer, format StreamFormat[Entry[K, V]]) (int64, error) {
+	return writeStream(w, entryFormat(format), valuesPrefix(binaryKindMap, m.size), func(yield func(Entry[K, V]) bool) {
+		m.ascend(m.root, nil, nil, func(key K, value V) bool {
+			return yield(Entry[K, V]{key, value})
+		})
+	})
+}
+
+// ReadStream replaces the contents of the map with entries read from r in
+// the given format until the end of the input, in any order. A later entry
+// wins over an earlier one with the same key. The map must have been
+// created with NewTreeMap or NewTreeMapFunc. Returns the number of bytes
+// read. The map is left unchanged if reading fails.
+func (m *TreeMap[K, V]) ReadStream(r io.Reader, format StreamFormat[Entry[K, V]]) (int64, error) {
+	if m.compare == nil {
+		return 0, fmt.Errorf("collections: cannot read into %T without a compare function", m)
+	}
+
+	fresh := NewTreeMapFunc[K, V](m.compare)
+	n, err := readStream(r, entryFormat(format), readValuesPrefix(binaryKindMap), func(entry Entry[K, V]) error {
+		fresh.Put(entry.Key, entry.Value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*m = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec
+// for keys and values.
+func (m *TreeMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return m.WriteStream(w, BinaryStream[Entry[K, V]](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with
+// DefaultCodec for keys and values.
+func (m *TreeMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	return m.ReadStream(r, BinaryStream[Entry[K, V]](nil))
+}
+
+// WriteStream writes the entries of the skip list to w in ascending key
+// order in the given format, one entry at a time. A binary format with a nil
+// codec uses EntryCodec with DefaultCodec for keys and values. Returns the
+// number of bytes written.
+func (s *SkipList[K, V]) WriteStream(w io.Writer, format StreamFormat[Entry[K, V]]) (int64, error) {
+	return writeStream(w, entryFormat(format), valuesPrefix(binaryKindMap, s.size), func(yield func(Entry[K, V]) bool) {
+		if s.head == nil {
+			return
+		}
+		for current := s.head.next[0]; current != nil; current = current.next[0] {
+			if !yield(Entry[K, V]{current.key, current.value}) {
+				return
+			}
+		}
+	})
+}
+
+// ReadStream replaces the contents of the skip list with entries read from r
+// in the given format until the end of the input, in any order. A later
+// entry wins over an earlier one with the same key. The skip list must have
+// been created with NewSkipList or NewSkipListFunc. Returns the number of
+// bytes read. The skip list is left unchanged if reading fails.
+func (s *SkipList[K, V]) ReadStream(r io.Reader, format StreamFormat[Entry[K, V]]) (int64, error) {
+	if s.compare == nil {
+		return 0, fmt.Errorf("collections: cannot read into %T without a compare function", s)
+	}
+
+	fresh := NewSkipListFunc[K, V](s.compare)
+	fresh.rng = s.rng
+	n, err := readStream(r, entryFormat(format), readValuesPrefix(binaryKindMap), func(entry Entry[K, V]) error {
+		fresh.Insert(entry.Key, entry.Value)
+		return nil
+	})
+	if err != nil {
+		return n, err
+	}
+	*s = *fresh
+	return n, nil
+}
+
+// WriteTo implements io.WriterTo using the binary format with DefaultCodec
+// for keys and values.
+func (s *SkipList[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return s.WriteStream(w, BinaryStream[Entry[K, V]](nil))
+}
+
+// ReadFrom implements io.ReaderFrom using the binary format with
+// DefaultCodec for keys and values.
+func (s *SkipList[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	return s.ReadStream(r, BinaryStream[Entry[K, V]](nil))
+}
@@ -0,0 +1,442 @@
+package collections
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"testing"
+	"testing/iotest"
+)
+
+// Compile-time checks that the containers implement io.WriterTo and io.ReaderFrom.
+var (
+	_ io.WriterTo   = (*Queue[int])(nil)
+	_ io.ReaderFrom = (*Queue[int])(nil)
+	_ io.WriterTo   = (*LinkedList[int])(nil)
+	_ io.ReaderFrom = (*LinkedList[int])(nil)
+	_ io.WriterTo   = (*Tree[int])(nil)
+	_ io.ReaderFrom = (*Tree[int])(nil)
+	_ io.WriterTo   = (*UnrolledList[int])(nil)
+	_ io.ReaderFrom = (*UnrolledList[int])(nil)
+	_ io.WriterTo   = (*AVLTree[int])(nil)
+	_ io.ReaderFrom = (*AVLTree[int])(nil)
+	_ io.WriterTo   = (*TreeSet[int])(nil)
+	_ io.ReaderFrom = (*TreeSet[int])(nil)
+	_ io.WriterTo   = (*TreeMap[int, string])(nil)
+	_ io.ReaderFrom = (*TreeMap[int, string])(nil)
+	_ io.WriterTo   = (*SkipList[int, string])(nil)
+	_ io.ReaderFrom = (*SkipList[int, string])(nil)
+)
+
+// failingWriter accepts limit bytes and then fails every write.
+type failingWriter struct {
+	limit   int
+	written int
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.written+len(p) > w.limit {
+		n := w.limit - w.written
+		w.written = w.limit
+		return n, errWriteFailed
+	}
+	w.written += len(p)
+	return len(p), nil
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	queue := NewBoundedQueue[int](100)
+	list := NewCircularLinkedList[int]()
+	unrolled := NewUnrolledListSize[int](4)
+	avl := NewAVLTree[int]()
+	set := NewTreeSet[int]()
+	for i := range 50 {
+		queue.Enqueue(i * 3)
+		list.Append(i - 25)
+		unrolled.Append(i)
+		avl.Insert(50 - i)
+		set.Add(i % 7)
+	}
+
+	tests := []struct {
+		name string
+		from io.WriterTo
+		to   io.ReaderFrom
+	}{
+		{"Queue", queue, NewQueue[int]()},
+		{"LinkedList", list, NewLinkedList[int]()},
+		{"Tree", newSampleTree(), NewTree[int]()},
+		{"UnrolledList", unrolled, NewUnrolledList[int]()},
+		{"AVLTree", avl, NewAVLTree[int]()},
+		{"TreeSet", set, NewTreeSet[int]()},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		written, err := tt.from.WriteTo(&buf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if written != int64(buf.Len()) {
+			t.Errorf("%s: WriteTo reported %d bytes, wrote %d", tt.name, written, buf.Len())
+		}
+
+		size := buf.Len()
+		read, err := tt.to.ReadFrom(&buf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if read != int64(size) {
+			t.Errorf("%s: ReadFrom reported %d bytes, expected %d", tt.name, read, size)
+		}
+
+		if got, want := tt.to.(Container[int]).ToSlice(), tt.from.(Container[int]).ToSlice(); !slices.Equal(got, want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, want, got)
+		}
+	}
+}
+
+func TestStreamKeepsMetadata(t *testing.T) {
+	queue := NewBoundedQueue[string](3)
+	queue.Enqueue("a")
+	var buf bytes.Buffer
+	queue.WriteTo(&buf)
+
+	var decodedQueue Queue[string]
+	if _, err := decodedQueue.ReadFrom(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decodedQueue.EnqueueAll([]string{"b", "c", "d"}) != 2 {
+		t.Error("Decoded queue should keep capacity 3")
+	}
+
+	list := NewCircularLinkedList[int]()
+	list.AppendAll([]int{1, 2})
+	buf.Reset()
+	list.WriteTo(&buf)
+
+	var decodedList LinkedList[int]
+	if _, err := decodedList.ReadFrom(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decodedList.IsCircular() || decodedList.Validate() != nil {
+		t.Error("Decoded list should be a valid circular list")
+	}
+
+	// The stream format is the same as MarshalBinary
+	buf.Reset()
+	tree := newSampleTree()
+	tree.WriteTo(&buf)
+	data, _ := tree.MarshalBinary()
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Error("WriteTo and MarshalBinary should produce the same bytes")
+	}
+}
+
+func TestNDJSONStream(t *testing.T) {
+	type event struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	list := NewLinkedListFrom(event{1, "start"}, event{2, "stop"})
+	var buf bytes.Buffer
+	if _, err := list.WriteStream(&buf, NDJSONStream[event]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "{\"id\":1,\"name\":\"start\"}\n{\"id\":2,\"name\":\"stop\"}\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	decoded := NewLinkedList[event]()
+	if _, err := decoded.ReadStream(&buf, NDJSONStream[event]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(list, nil) {
+		t.Errorf("Expected %v, got %v", list, decoded)
+	}
+
+	// Malformed lines are reported
+	if _, err := decoded.ReadStream(bytes.NewBufferString("{\"id\":1}\n{\"id\":"), NDJSONStream[event]()); err == nil {
+		t.Error("Expected an error for a truncated line")
+	}
+	if decoded.Len() != 2 {
+		t.Error("A failed read should leave the list unchanged")
+	}
+}
+
+func TestNDJSONStreamTree(t *testing.T) {
+	bst := NewBST[int]()
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		bst.InsertBST(v)
+	}
+
+	var buf bytes.Buffer
+	bst.WriteStream(&buf, NDJSONStream[int]())
+	if buf.String() != "5\n3\n8\n1\n4\n" {
+		t.Errorf("Expected values in level order, got %q", buf.String())
+	}
+
+	// Level order rebuilds the same BST, and the same complete tree with Insert
+	decoded := NewBST[int]()
+	if _, err := decoded.ReadStream(bytes.NewReader(buf.Bytes()), NDJSONStream[int]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !decoded.Equal(bst, nil) {
+		t.Errorf("Expected the same BST, got %v", decoded.ToSlice())
+	}
+
+	plain := NewTree[int]()
+	plain.ReadStream(bytes.NewReader(buf.Bytes()), NDJSONStream[int]())
+	if !slices.Equal(plain.LevelOrder(), []int{5, 3, 8, 1, 4}) || !plain.IsComplete() {
+		t.Errorf("Expected a complete tree, got %v", plain.LevelOrder())
+	}
+}
+
+func TestCSVStream(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	format := CSVStream(
+		func(p person) ([]string, error) { return []string{p.Name, strconv.Itoa(p.Age)}, nil },
+		func(record []string) (person, error) {
+			age, err := strconv.Atoi(record[1])
+			return person{record[0], age}, err
+		},
+	)
+
+	q := NewQueue[person]()
+	q.Enqueue(person{"Alice", 30})
+	q.Enqueue(person{"Bob, Jr.", 5})
+
+	var buf bytes.Buffer
+	if _, err := q.WriteStream(&buf, format); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buf.String() != "Alice,30\n\"Bob, Jr.\",5\n" {
+		t.Errorf("Unexpected CSV %q", buf.String())
+	}
+
+	decoded := NewBoundedQueue[person](2)
+	if _, err := decoded.ReadStream(&buf, format); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(decoded.ToSlice(), q.ToSlice()) {
+		t.Errorf("Expected %v, got %v", q.ToSlice(), decoded.ToSlice())
+	}
+
+	// Values are checked by fromRecord and the capacity of the queue is kept
+	if _, err := decoded.ReadStream(bytes.NewBufferString("Carol,old\n"), format); err == nil {
+		t.Error("Expected the fromRecord error to be returned")
+	}
+	if _, err := decoded.ReadStream(bytes.NewBufferString("a,1\nb,2\nc,3\n"), format); err == nil {
+		t.Error("Expected an error when the values do not fit the queue")
+	}
+}
+
+func TestStreamPartialReads(t *testing.T) {
+	list := NewLinkedList[string]()
+	for i := range 1000 {
+		list.Append(strconv.Itoa(i))
+	}
+	var buf bytes.Buffer
+	list.WriteTo(&buf)
+	data := buf.Bytes()
+
+	readers := map[string]io.Reader{
+		"one byte": iotest.OneByteReader(bytes.NewReader(data)),
+		"half":     iotest.HalfReader(bytes.NewReader(data)),
+		"data+EOF": iotest.DataErrReader(bytes.NewReader(data)),
+		"plain":    bytes.NewReader(data),
+	}
+	for name, r := range readers {
+		decoded := NewLinkedList[string]()
+		n, err := decoded.ReadFrom(r)
+		if err != nil || n != int64(len(data)) {
+			t.Errorf("%s: expected %d bytes, got %d (error %v)", name, len(data), n, err)
+		}
+		if !decoded.Equal(list, nil) {
+			t.Errorf("%s: decoded list does not match", name)
+		}
+	}
+
+	// Errors from the reader are returned
+	decoded := NewLinkedListFrom("keep")
+	if _, err := decoded.ReadFrom(iotest.TimeoutReader(iotest.OneByteReader(bytes.NewReader(data)))); !errors.Is(err, iotest.ErrTimeout) {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+	if !slices.Equal(decoded.ToSlice(), []string{"keep"}) {
+		t.Error("A failed read should leave the list unchanged")
+	}
+}
+
+func TestStreamTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	newSampleTree().WriteTo(&buf)
+	data := buf.Bytes()
+
+	for i := range len(data) {
+		tree := NewTree[int]()
+		tree.Insert(42)
+		_, err := tree.ReadFrom(bytes.NewReader(data[:i]))
+		if !errors.Is(err, ErrInvalidEncoding) || !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Cut at %d: expected an unexpected EOF, got %v", i, err)
+		}
+		if tree.Size() != 1 {
+			t.Errorf("Cut at %d: a failed read should leave the tree unchanged", i)
+		}
+	}
+
+	// Data after the last value is rejected too
+	if _, err := NewTree[int]().ReadFrom(bytes.NewReader(append(data, 0))); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding for trailing data, got %v", err)
+	}
+}
+
+func TestStreamWriterErrors(t *testing.T) {
+	list := NewLinkedList[int]()
+	for i := range 10_000 {
+		list.Append(i)
+	}
+	var buf bytes.Buffer
+	total, _ := list.WriteTo(&buf)
+
+	for _, limit := range []int{0, 3, 4096, int(total) - 1} {
+		w := &failingWriter{limit: limit}
+		n, err := list.WriteTo(w)
+		if !errors.Is(err, errWriteFailed) {
+			t.Errorf("Limit %d: expected the writer error, got %v", limit, err)
+		}
+		if n != int64(limit) {
+			t.Errorf("Limit %d: expected %d bytes reported, got %d", limit, limit, n)
+		}
+	}
+
+	for _, format := range []StreamFormat[int]{NDJSONStream[int](), CSVStream(
+		func(v int) ([]string, error) { return []string{strconv.Itoa(v)}, nil },
+		func(r []string) (int, error) { return strconv.Atoi(r[0]) },
+	)} {
+		if _, err := list.WriteStream(&failingWriter{limit: 100}, format); !errors.Is(err, errWriteFailed) {
+			t.Errorf("Expected the writer error, got %v", err)
+		}
+	}
+
+	// Errors from the codec stop the stream
+	failing := NewCodec(
+		func(v int) ([]byte, error) {
+			if v == 5 {
+				return nil, errors.New("cannot encode 5")
+			}
+			return []byte{byte(v)}, nil
+		},
+		func([]byte) (int, error) { return 0, nil },
+	)
+	if _, err := list.WriteStream(io.Discard, BinaryStream(failing)); err == nil {
+		t.Error("Expected the codec error to be returned")
+	}
+}
+
+func TestStreamMaps(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	for i := range 50 {
+		m.Put(50-i, strconv.Itoa(i))
+	}
+
+	var buf bytes.Buffer
+	written, err := m.WriteTo(&buf)
+	if err != nil || written != int64(buf.Len()) {
+		t.Fatalf("Unexpected result %d, %v", written, err)
+	}
+
+	// TreeMap and SkipList share the format
+	skip := NewSkipList[int, string]()
+	skip.Insert(999, "dropped")
+	size := buf.Len()
+	if read, err := skip.ReadFrom(&buf); err != nil || read != int64(size) {
+		t.Fatalf("Unexpected result %d, %v", read, err)
+	}
+	if !slices.Equal(skip.Keys(), m.Keys()) || skip.Contains(999) {
+		t.Errorf("Expected keys %v, got %v", m.Keys(), skip.Keys())
+	}
+	if value, _ := skip.Get(7); value != "43" {
+		t.Errorf("Expected value 43, got %q", value)
+	}
+
+	buf.Reset()
+	skip.WriteTo(&buf)
+	restored := NewTreeMap[int, string]()
+	if _, err := restored.ReadFrom(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if restored.String() != m.String() {
+		t.Errorf("Expected %s, got %s", m, restored)
+	}
+
+	// NDJSON stores {"key": k, "value": v} objects; a later entry wins
+	input := `{"key": 2, "value": "b"}` + "\n" + `{"key": 1, "value": "a"}` + "\n" + `{"key": 2, "value": "c"}` + "\n"
+	if _, err := restored.ReadStream(bytes.NewBufferString(input), NDJSONStream[Entry[int, string]]()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if str := restored.String(); str != "TreeMap{1: a, 2: c}" {
+		t.Errorf("Unexpected map %s", str)
+	}
+	buf.Reset()
+	restored.WriteStream(&buf, NDJSONStream[Entry[int, string]]())
+	if buf.String() != `{"key":1,"value":"a"}`+"\n"+`{"key":2,"value":"c"}`+"\n" {
+		t.Errorf("Unexpected NDJSON %q", buf.String())
+	}
+
+	// Custom codecs for keys and values
+	format := BinaryStream(EntryCodec(JSONCodec[int](), GobCodec[string]()))
+	buf.Reset()
+	m.WriteStream(&buf, format)
+	decoded := NewSkipList[int, string]()
+	if _, err := decoded.ReadStream(&buf, format); err != nil || decoded.Len() != 50 {
+		t.Errorf("Expected 50 entries, got %d (error %v)", decoded.Len(), err)
+	}
+
+	// Truncated input leaves the map unchanged
+	buf.Reset()
+	m.WriteTo(&buf)
+	data := buf.Bytes()
+	for i := range len(data) {
+		if _, err := restored.ReadFrom(bytes.NewReader(data[:i])); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("Cut at %d: expected ErrInvalidEncoding, got %v", i, err)
+		}
+	}
+	if restored.Len() != 2 {
+		t.Error("A failed read should leave the map unchanged")
+	}
+
+	// A key length past the end of the entry is rejected
+	if _, err := EntryCodec[int, int](nil, nil).Decode([]byte{5, 1}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding, got %v", err)
+	}
+}
+
+func TestStreamWithoutCompare(t *testing.T) {
+	var buf bytes.Buffer
+	NewAVLTree[int]().WriteTo(&buf)
+
+	if _, err := (&AVLTree[int]{}).ReadFrom(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("Expected an error reading into an AVLTree without a compare function")
+	}
+	if _, err := (&TreeSet[int]{}).ReadFrom(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("Expected an error reading into a TreeSet without a compare function")
+	}
+	if _, err := (&TreeMap[int, int]{}).ReadFrom(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("Expected an error reading into a TreeMap without a compare function")
+	}
+	if _, err := (&SkipList[int, int]{}).ReadFrom(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("Expected an error reading into a SkipList without a compare function")
+	}
+	if _, err := NewAVLTree[int]().ReadFrom(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
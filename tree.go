@@ -16,8 +16,9 @@ type Node[T any] struct {
 
 // Tree represents a binary tree data structure.
 type Tree[T any] struct {
-	root *Node[T]
-	size int
+	root    *Node[T]
+	size    int
+	compare func(a, b T) int // nil unless the tree is used as a BST
 }
 
 // NewTree creates and returns a new empty binary tree.
@@ -90,18 +91,33 @@ func (t *Tree[T]) String() string {
 	return output
 }
 
-// MaxDepth returns the maximum depth of the tree using DFS algorithm.
+// MaxDepth returns the maximum depth of the tree using an iterative DFS
+// with an explicit stack, so deep trees do not grow the call stack.
 func (t *Tree[T]) MaxDepth() int {
-	return dfs(t.root)
-}
-
-func dfs[T any](root *Node[T]) int {
-	if root == nil {
+	if t.root == nil {
 		return 0
 	}
-	left := dfs(root.Left)
-	right := dfs(root.Right)
-	return 1 + max(left, right)
+
+	type entry struct {
+		node  *Node[T]
+		depth int
+	}
+
+	maxDepth := 0
+	stack := []entry{{t.root, 1}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		maxDepth = max(maxDepth, current.depth)
+
+		if current.node.Left != nil {
+			stack = append(stack, entry{current.node.Left, current.depth + 1})
+		}
+		if current.node.Right != nil {
+			stack = append(stack, entry{current.node.Right, current.depth + 1})
+		}
+	}
+	return maxDepth
 }
 
 // Contains checks if a value exists in the tree using BFS.
@@ -163,6 +179,11 @@ func (t *Tree[T]) Size() int {
 	return t.size
 }
 
+// Len returns the number of nodes in the tree. It is the same as Size.
+func (t *Tree[T]) Len() int {
+	return t.size
+}
+
 // Clear removes all nodes from the tree.
 func (t *Tree[T]) Clear() {
 	t.root = nil
@@ -171,57 +192,118 @@ func (t *Tree[T]) Clear() {
 
 // InOrder performs an in-order traversal (Left, Root, Right) and returns the values.
 func (t *Tree[T]) InOrder() []T {
-	result := []T{}
-	inOrderHelper(t.root, &result)
-	return result
-}
-
-func inOrderHelper[T any](node *Node[T], result *[]T) {
-	if node == nil {
-		return
-	}
-	inOrderHelper(node.Left, result)
-	*result = append(*result, node.Value)
-	inOrderHelper(node.Right, result)
+	return t.collect(t.WalkInOrder)
 }
 
 // PreOrder performs a pre-order traversal (Root, Left, Right) and returns the values.
 func (t *Tree[T]) PreOrder() []T {
-	result := []T{}
-	preOrderHelper(t.root, &result)
-	return result
-}
-
-func preOrderHelper[T any](node *Node[T], result *[]T) {
-	if node == nil {
-		return
-	}
-	*result = append(*result, node.Value)
-	preOrderHelper(node.Left, result)
-	preOrderHelper(node.Right, result)
+	return t.collect(t.WalkPreOrder)
 }
 
 // PostOrder performs a post-order traversal (Left, Right, Root) and returns the values.
 func (t *Tree[T]) PostOrder() []T {
-	result := []T{}
-	postOrderHelper(t.root, &result)
+	return t.collect(t.WalkPostOrder)
+}
+
+// LevelOrder performs a level-order (BFS) traversal and returns the values.
+func (t *Tree[T]) LevelOrder() []T {
+	return t.collect(t.WalkLevelOrder)
+}
+
+// ToSlice returns the values of the tree in level order, the same order
+// Insert fills the tree in.
+func (t *Tree[T]) ToSlice() []T {
+	return t.LevelOrder()
+}
+
+// collect gathers every value visited by walk into a slice.
+func (t *Tree[T]) collect(walk func(func(T) bool)) []T {
+	result := make([]T, 0, t.size)
+	walk(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
 	return result
 }
 
-func postOrderHelper[T any](node *Node[T], result *[]T) {
-	if node == nil {
+// WalkInOrder visits the values in in-order (Left, Root, Right) using an
+// explicit stack. The walk stops early if fn returns false.
+func (t *Tree[T]) WalkInOrder(fn func(T) bool) {
+	stack := []*Node[T]{}
+	current := t.root
+
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.Left
+		}
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !fn(current.Value) {
+			return
+		}
+		current = current.Right
+	}
+}
+
+// WalkPreOrder visits the values in pre-order (Root, Left, Right) using an
+// explicit stack. The walk stops early if fn returns false.
+func (t *Tree[T]) WalkPreOrder(fn func(T) bool) {
+	if t.root == nil {
 		return
 	}
-	postOrderHelper(node.Left, result)
-	postOrderHelper(node.Right, result)
-	*result = append(*result, node.Value)
+
+	stack := []*Node[T]{t.root}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !fn(current.Value) {
+			return
+		}
+		// Push right first so the left subtree is visited first
+		if current.Right != nil {
+			stack = append(stack, current.Right)
+		}
+		if current.Left != nil {
+			stack = append(stack, current.Left)
+		}
+	}
 }
 
-// LevelOrder performs a level-order (BFS) traversal and returns the values.
-func (t *Tree[T]) LevelOrder() []T {
-	result := []T{}
+// WalkPostOrder visits the values in post-order (Left, Right, Root) using an
+// explicit stack. The walk stops early if fn returns false.
+func (t *Tree[T]) WalkPostOrder(fn func(T) bool) {
+	stack := []*Node[T]{}
+	var lastVisited *Node[T]
+	current := t.root
+
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.Left
+		}
+
+		top := stack[len(stack)-1]
+		if top.Right != nil && top.Right != lastVisited {
+			current = top.Right
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		if !fn(top.Value) {
+			return
+		}
+		lastVisited = top
+	}
+}
+
+// WalkLevelOrder visits the values level by level (BFS).
+// The walk stops early if fn returns false.
+func (t *Tree[T]) WalkLevelOrder(fn func(T) bool) {
 	if t.root == nil {
-		return result
+		return
 	}
 
 	queue := NewQueue[*Node[T]]()
@@ -229,7 +311,9 @@ func (t *Tree[T]) LevelOrder() []T {
 
 	for !queue.IsEmpty() {
 		current, _ := queue.Next()
-		result = append(result, current.Value)
+		if !fn(current.Value) {
+			return
+		}
 		if current.Left != nil {
 			queue.Enqueue(current.Left)
 		}
@@ -237,41 +321,275 @@ func (t *Tree[T]) LevelOrder() []T {
 			queue.Enqueue(current.Right)
 		}
 	}
-	return result
+}
+
+// MorrisInOrder visits the values in in-order using Morris traversal, which
+// needs O(1) extra space. The tree is temporarily rethreaded through the
+// Right fields of its nodes and restored before returning, so it must not be
+// read or modified concurrently. If fn returns false no more values are
+// visited, but the walk still completes to restore the tree.
+func (t *Tree[T]) MorrisInOrder(fn func(T) bool) {
+	visiting := true
+	current := t.root
+
+	for current != nil {
+		if current.Left == nil {
+			if visiting {
+				visiting = fn(current.Value)
+			}
+			current = current.Right
+			continue
+		}
+
+		predecessor := current.Left
+		for predecessor.Right != nil && predecessor.Right != current {
+			predecessor = predecessor.Right
+		}
+
+		if predecessor.Right == nil {
+			predecessor.Right = current
+			current = current.Left
+		} else {
+			predecessor.Right = nil
+			if visiting {
+				visiting = fn(current.Value)
+			}
+			current = current.Right
+		}
+	}
+}
+
+// MorrisPreOrder visits the values in pre-order using Morris traversal, which
+// needs O(1) extra space. The same caveats as MorrisInOrder apply.
+func (t *Tree[T]) MorrisPreOrder(fn func(T) bool) {
+	visiting := true
+	current := t.root
+
+	for current != nil {
+		if current.Left == nil {
+			if visiting {
+				visiting = fn(current.Value)
+			}
+			current = current.Right
+			continue
+		}
+
+		predecessor := current.Left
+		for predecessor.Right != nil && predecessor.Right != current {
+			predecessor = predecessor.Right
+		}
+
+		if predecessor.Right == nil {
+			if visiting {
+				visiting = fn(current.Value)
+			}
+			predecessor.Right = current
+			current = current.Left
+		} else {
+			predecessor.Right = nil
+			current = current.Right
+		}
+	}
 }
 
 // MinDepth returns the minimum depth of the tree (shortest path from root to leaf).
+// It uses BFS, so it stops at the first leaf found.
 func (t *Tree[T]) MinDepth() int {
-	return minDepthHelper(t.root)
+	if t.root == nil {
+		return 0
+	}
+
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for depth := 1; ; depth++ {
+		for range queue.Len() {
+			current, _ := queue.Next()
+			if current.Left == nil && current.Right == nil {
+				return depth
+			}
+			if current.Left != nil {
+				queue.Enqueue(current.Left)
+			}
+			if current.Right != nil {
+				queue.Enqueue(current.Right)
+			}
+		}
+	}
 }
 
-func minDepthHelper[T any](node *Node[T]) int {
-	if node == nil {
-		return 0
+// CountLeaves returns the number of leaf nodes in the tree.
+func (t *Tree[T]) CountLeaves() int {
+	count := 0
+	walkNodes(t.root, func(node *Node[T]) {
+		if node.Left == nil && node.Right == nil {
+			count++
+		}
+	})
+	return count
+}
+
+// walkNodes visits every node of the subtree in pre-order using an explicit stack.
+func walkNodes[T any](root *Node[T], fn func(*Node[T])) {
+	if root == nil {
+		return
+	}
+
+	stack := []*Node[T]{root}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		fn(current)
+		if current.Right != nil {
+			stack = append(stack, current.Right)
+		}
+		if current.Left != nil {
+			stack = append(stack, current.Left)
+		}
+	}
+}
+
+// Delete removes the first node with the given value found using BFS.
+// The deleted node takes the value of the deepest rightmost node, which is
+// then removed, so a tree built with Insert stays complete.
+// Returns true if a value was removed, false otherwise.
+func (t *Tree[T]) Delete(value T) bool {
+	if t.root == nil {
+		return false
+	}
+
+	var target, last, lastParent *Node[T]
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		if target == nil && any(current.Value) == any(value) {
+			target = current
+		}
+		if current.Left != nil {
+			lastParent = current
+			queue.Enqueue(current.Left)
+		}
+		if current.Right != nil {
+			lastParent = current
+			queue.Enqueue(current.Right)
+		}
+		last = current
+	}
+
+	if target == nil {
+		return false
 	}
-	if node.Left == nil && node.Right == nil {
-		return 1
+
+	target.Value = last.Value
+	switch {
+	case lastParent == nil:
+		t.root = nil
+	case lastParent.Right == last:
+		lastParent.Right = nil
+	default:
+		lastParent.Left = nil
+	}
+
+	t.size--
+	return true
+}
+
+// DeleteSubtree removes the given node and all of its descendants.
+// Returns false if the node is not part of the tree.
+func (t *Tree[T]) DeleteSubtree(node *Node[T]) bool {
+	if node == nil || t.root == nil {
+		return false
 	}
-	if node.Left == nil {
-		return 1 + minDepthHelper(node.Right)
+
+	if node == t.root {
+		t.Clear()
+		return true
 	}
-	if node.Right == nil {
-		return 1 + minDepthHelper(node.Left)
+
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		if current.Left == node {
+			current.Left = nil
+			t.size -= countNodesHelper(node)
+			return true
+		}
+		if current.Right == node {
+			current.Right = nil
+			t.size -= countNodesHelper(node)
+			return true
+		}
+		if current.Left != nil {
+			queue.Enqueue(current.Left)
+		}
+		if current.Right != nil {
+			queue.Enqueue(current.Right)
+		}
 	}
-	return 1 + min(minDepthHelper(node.Left), minDepthHelper(node.Right))
+	return false
 }
 
-// CountLeaves returns the number of leaf nodes in the tree.
-func (t *Tree[T]) CountLeaves() int {
-	return countLeavesHelper(t.root)
+// ReplaceValue replaces the first occurrence of oldValue found using BFS with newValue.
+// Returns true if a value was replaced, false otherwise.
+func (t *Tree[T]) ReplaceValue(oldValue, newValue T) bool {
+	node, found := t.Search(oldValue)
+	if !found {
+		return false
+	}
+	node.Value = newValue
+	return true
 }
 
-func countLeavesHelper[T any](node *Node[T]) int {
-	if node == nil {
+// Prune removes every subtree whose root matches the predicate.
+// Returns the number of nodes removed.
+func (t *Tree[T]) Prune(fn func(T) bool) int {
+	if t.root == nil {
 		return 0
 	}
-	if node.Left == nil && node.Right == nil {
-		return 1
+
+	if fn(t.root.Value) {
+		removed := t.size
+		t.Clear()
+		return removed
 	}
-	return countLeavesHelper(node.Left) + countLeavesHelper(node.Right)
+
+	removed := 0
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		if current.Left != nil {
+			if fn(current.Left.Value) {
+				removed += countNodesHelper(current.Left)
+				current.Left = nil
+			} else {
+				queue.Enqueue(current.Left)
+			}
+		}
+		if current.Right != nil {
+			if fn(current.Right.Value) {
+				removed += countNodesHelper(current.Right)
+				current.Right = nil
+			} else {
+				queue.Enqueue(current.Right)
+			}
+		}
+	}
+
+	t.size -= removed
+	return removed
+}
+
+func countNodesHelper[T any](node *Node[T]) int {
+	count := 0
+	walkNodes(node, func(*Node[T]) {
+		count++
+	})
+	return count
 }
@@ -488,3 +488,246 @@ func TestManualTreeConstruction(t *testing.T) {
 		t.Errorf("Expected 2 leaves (3 and 4), got %d", tree.CountLeaves())
 	}
 }
+
+func TestTreeDelete(t *testing.T) {
+	//       1
+	//      / \
+	//     2   3
+	//    / \
+	//   4   5
+	tree := NewTree[int]()
+	for i := 1; i <= 5; i++ {
+		tree.Insert(i)
+	}
+
+	// 2 is replaced by the deepest rightmost node (5)
+	if !tree.Delete(2) {
+		t.Error("Delete(2) should succeed")
+	}
+	if tree.Delete(42) {
+		t.Error("Delete of a missing value should return false")
+	}
+	if tree.Size() != 4 {
+		t.Errorf("Expected size 4, got %d", tree.Size())
+	}
+
+	expected := []int{1, 5, 3, 4}
+	levelOrder := tree.LevelOrder()
+	for i, val := range expected {
+		if levelOrder[i] != val {
+			t.Errorf("Expected levelOrder[%d] = %d, got %d", i, val, levelOrder[i])
+		}
+	}
+
+	// The tree stays complete, so Insert fills the freed slot
+	tree.Insert(6)
+	if tree.root.Left.Right == nil || tree.root.Left.Right.Value != 6 {
+		t.Error("Insert after Delete should fill the freed slot")
+	}
+
+	for _, val := range []int{1, 3, 4, 5, 6} {
+		tree.Delete(val)
+	}
+	if !tree.IsEmpty() || tree.Size() != 0 {
+		t.Error("Tree should be empty after deleting every value")
+	}
+}
+
+func TestDeleteSubtree(t *testing.T) {
+	tree := NewTree[int]()
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i)
+	}
+
+	node, _ := tree.Search(2)
+	if !tree.DeleteSubtree(node) {
+		t.Error("DeleteSubtree should succeed")
+	}
+	if tree.Size() != 4 {
+		t.Errorf("Expected size 4, got %d", tree.Size())
+	}
+	if tree.Contains(4) || tree.Contains(5) {
+		t.Error("Descendants of the deleted node should be removed")
+	}
+
+	if tree.DeleteSubtree(NewNode(3)) {
+		t.Error("DeleteSubtree of a node outside the tree should fail")
+	}
+
+	if !tree.DeleteSubtree(tree.root) {
+		t.Error("DeleteSubtree of the root should succeed")
+	}
+	if !tree.IsEmpty() || tree.Size() != 0 {
+		t.Error("Tree should be empty after deleting the root subtree")
+	}
+}
+
+func TestReplaceValue(t *testing.T) {
+	tree := NewTree[string]()
+	tree.Insert("a")
+	tree.Insert("b")
+
+	if !tree.ReplaceValue("b", "c") {
+		t.Error("ReplaceValue should succeed")
+	}
+	if tree.Contains("b") || !tree.Contains("c") {
+		t.Error("Value should be replaced")
+	}
+	if tree.ReplaceValue("z", "y") {
+		t.Error("ReplaceValue of a missing value should fail")
+	}
+	if tree.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", tree.Size())
+	}
+}
+
+func TestPrune(t *testing.T) {
+	//        1
+	//      /   \
+	//     2     3
+	//    / \   / \
+	//   4   5 6   7
+	tree := NewTree[int]()
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i)
+	}
+
+	removed := tree.Prune(func(v int) bool { return v == 2 || v == 7 })
+	if removed != 4 {
+		t.Errorf("Expected 4 nodes removed, got %d", removed)
+	}
+	if tree.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", tree.Size())
+	}
+
+	expected := []int{1, 3, 6}
+	levelOrder := tree.LevelOrder()
+	for i, val := range expected {
+		if levelOrder[i] != val {
+			t.Errorf("Expected levelOrder[%d] = %d, got %d", i, val, levelOrder[i])
+		}
+	}
+
+	if removed := tree.Prune(func(v int) bool { return v == 1 }); removed != 3 {
+		t.Errorf("Expected 3 nodes removed, got %d", removed)
+	}
+	if !tree.IsEmpty() {
+		t.Error("Tree should be empty after pruning the root")
+	}
+}
+
+func TestWalkEarlyStop(t *testing.T) {
+	tree := NewTree[int]()
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i)
+	}
+
+	walks := []struct {
+		name     string
+		walk     func(func(int) bool)
+		expected []int
+	}{
+		{"WalkInOrder", tree.WalkInOrder, []int{4, 2, 5}},
+		{"WalkPreOrder", tree.WalkPreOrder, []int{1, 2, 4}},
+		{"WalkPostOrder", tree.WalkPostOrder, []int{4, 5, 2}},
+		{"WalkLevelOrder", tree.WalkLevelOrder, []int{1, 2, 3}},
+		{"MorrisInOrder", tree.MorrisInOrder, []int{4, 2, 5}},
+		{"MorrisPreOrder", tree.MorrisPreOrder, []int{1, 2, 4}},
+	}
+
+	for _, w := range walks {
+		visited := []int{}
+		w.walk(func(v int) bool {
+			visited = append(visited, v)
+			return len(visited) < 3
+		})
+
+		if len(visited) != len(w.expected) {
+			t.Errorf("%s: expected %v, got %v", w.name, w.expected, visited)
+			continue
+		}
+		for i, val := range w.expected {
+			if visited[i] != val {
+				t.Errorf("%s: expected visited[%d] = %d, got %d", w.name, i, val, visited[i])
+			}
+		}
+	}
+}
+
+func TestMorrisTraversal(t *testing.T) {
+	tree := NewTree[int]()
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i)
+	}
+	inOrder := tree.InOrder()
+	preOrder := tree.PreOrder()
+
+	morrisIn := []int{}
+	tree.MorrisInOrder(func(v int) bool {
+		morrisIn = append(morrisIn, v)
+		return true
+	})
+	morrisPre := []int{}
+	tree.MorrisPreOrder(func(v int) bool {
+		morrisPre = append(morrisPre, v)
+		return true
+	})
+
+	for i, val := range inOrder {
+		if morrisIn[i] != val {
+			t.Errorf("Expected morrisIn[%d] = %d, got %d", i, val, morrisIn[i])
+		}
+	}
+	for i, val := range preOrder {
+		if morrisPre[i] != val {
+			t.Errorf("Expected morrisPre[%d] = %d, got %d", i, val, morrisPre[i])
+		}
+	}
+
+	// Stopping early must still restore the tree
+	tree.MorrisInOrder(func(int) bool { return false })
+	tree.MorrisPreOrder(func(int) bool { return false })
+	after := tree.InOrder()
+	for i, val := range inOrder {
+		if after[i] != val {
+			t.Errorf("Tree changed after Morris traversal: expected after[%d] = %d, got %d", i, val, after[i])
+		}
+	}
+}
+
+func TestDeepTree(t *testing.T) {
+	// A degenerate tree that would need a million nested calls if traversed recursively
+	const depth = 1_000_000
+	tree := NewTree[int]()
+	tree.root = NewNode(0)
+	current := tree.root
+	for i := 1; i < depth; i++ {
+		if i%2 == 0 {
+			current.Left = NewNode(i)
+			current = current.Left
+		} else {
+			current.Right = NewNode(i)
+			current = current.Right
+		}
+	}
+	tree.size = depth
+
+	if tree.MaxDepth() != depth {
+		t.Errorf("Expected max depth %d, got %d", depth, tree.MaxDepth())
+	}
+	if tree.MinDepth() != depth {
+		t.Errorf("Expected min depth %d, got %d", depth, tree.MinDepth())
+	}
+	if tree.CountLeaves() != 1 {
+		t.Errorf("Expected 1 leaf, got %d", tree.CountLeaves())
+	}
+	if len(tree.InOrder()) != depth {
+		t.Errorf("Expected %d values in-order, got %d", depth, len(tree.InOrder()))
+	}
+	if len(tree.PostOrder()) != depth {
+		t.Errorf("Expected %d values post-order, got %d", depth, len(tree.PostOrder()))
+	}
+	if post := tree.PostOrder(); post[0] != depth-1 {
+		t.Errorf("Expected deepest node first in post-order, got %d", post[0])
+	}
+}
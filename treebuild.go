@@ -0,0 +1,219 @@
+package collections
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrInvalidTraversal is returned when traversal input cannot describe a tree.
+var ErrInvalidTraversal = errors.New("collections: invalid traversal")
+
+// NewTreeFromLevelOrder builds a tree from a level-order slice where
+// nilMarker marks a missing child, as in [1, nil, 2, 3]. Children of
+// missing nodes are not listed, and trailing markers may be omitted.
+// Returns an error wrapping ErrInvalidTraversal if values remain after
+// every node has been given its children, or if nilMarker cannot be
+// compared with ==, as with a slice.
+func NewTreeFromLevelOrder[T any](values []T, nilMarker T) (*Tree[T], error) {
+	if !hashable(any(nilMarker)) {
+		return nil, fmt.Errorf("%w: nil marker %v cannot be compared", ErrInvalidTraversal, nilMarker)
+	}
+
+	tree := NewTree[T]()
+	isNil := func(v T) bool { return any(v) == any(nilMarker) }
+
+	if len(values) == 0 {
+		return tree, nil
+	}
+	if isNil(values[0]) {
+		for i, v := range values[1:] {
+			if !isNil(v) {
+				return nil, fmt.Errorf("%w: value at index %d has no parent", ErrInvalidTraversal, i+1)
+			}
+		}
+		return tree, nil
+	}
+
+	tree.root = NewNode(values[0])
+	tree.size = 1
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(tree.root)
+
+	i := 1
+	for i < len(values) {
+		parent, ok := queue.Next()
+		if !ok {
+			return nil, fmt.Errorf("%w: value at index %d has no parent", ErrInvalidTraversal, i)
+		}
+
+		if !isNil(values[i]) {
+			parent.Left = NewNode(values[i])
+			queue.Enqueue(parent.Left)
+			tree.size++
+		}
+		i++
+
+		if i < len(values) && !isNil(values[i]) {
+			parent.Right = NewNode(values[i])
+			queue.Enqueue(parent.Right)
+			tree.size++
+		}
+		i++
+	}
+
+	return tree, nil
+}
+
+// hashable reports whether v can be used as a map key. Slices, maps and
+// funcs cannot, nor can interfaces, structs or arrays holding them.
+func hashable(v any) bool {
+	return v == nil || reflect.ValueOf(v).Comparable()
+}
+
+// inOrderIndex maps each value of an in-order traversal to its position.
+// Returns an error if a value appears more than once, since the shape of
+// the tree would then be ambiguous, or if a value cannot be a map key.
+func inOrderIndex[T any](inOrder []T) (map[any]int, error) {
+	index := make(map[any]int, len(inOrder))
+	for i, v := range inOrder {
+		if !hashable(any(v)) {
+			return nil, fmt.Errorf("%w: value %v cannot be compared", ErrInvalidTraversal, v)
+		}
+		if _, ok := index[any(v)]; ok {
+			return nil, fmt.Errorf("%w: duplicate value %v", ErrInvalidTraversal, v)
+		}
+		index[any(v)] = i
+	}
+	return index, nil
+}
+
+// lookupIndex returns the position of v in an index built by inOrderIndex.
+// Returns false if v is not there, including when it cannot be a map key.
+func lookupIndex[T any](index map[any]int, v T) (int, bool) {
+	if !hashable(any(v)) {
+		return 0, false
+	}
+	i, ok := index[any(v)]
+	return i, ok
+}
+
+// FromPreAndInOrder rebuilds a tree from its pre-order and in-order traversals.
+// Values must be unique and comparable with ==.
+// Returns an error wrapping ErrInvalidTraversal if the traversals do not
+// describe the same tree.
+func FromPreAndInOrder[T any](preOrder, inOrder []T) (*Tree[T], error) {
+	if len(preOrder) != len(inOrder) {
+		return nil, fmt.Errorf("%w: traversals have lengths %d and %d", ErrInvalidTraversal, len(preOrder), len(inOrder))
+	}
+
+	index, err := inOrderIndex(inOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	next := 0
+	var build func(lo, hi int) (*Node[T], error)
+	build = func(lo, hi int) (*Node[T], error) {
+		if lo > hi {
+			return nil, nil
+		}
+
+		value := preOrder[next]
+		next++
+		mid, ok := lookupIndex(index, value)
+		if !ok || mid < lo || mid > hi {
+			return nil, fmt.Errorf("%w: value %v does not fit the in-order traversal", ErrInvalidTraversal, value)
+		}
+
+		node := NewNode(value)
+		if node.Left, err = build(lo, mid-1); err != nil {
+			return nil, err
+		}
+		if node.Right, err = build(mid+1, hi); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+
+	root, err := build(0, len(inOrder)-1)
+	if err != nil {
+		return nil, err
+	}
+	return &Tree[T]{root: root, size: len(inOrder)}, nil
+}
+
+// FromPostAndInOrder rebuilds a tree from its post-order and in-order traversals.
+// Values must be unique and comparable with ==.
+// Returns an error wrapping ErrInvalidTraversal if the traversals do not
+// describe the same tree.
+func FromPostAndInOrder[T any](postOrder, inOrder []T) (*Tree[T], error) {
+	if len(postOrder) != len(inOrder) {
+		return nil, fmt.Errorf("%w: traversals have lengths %d and %d", ErrInvalidTraversal, len(postOrder), len(inOrder))
+	}
+
+	index, err := inOrderIndex(inOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the post-order traversal backwards: Root, Right, Left
+	next := len(postOrder) - 1
+	var build func(lo, hi int) (*Node[T], error)
+	build = func(lo, hi int) (*Node[T], error) {
+		if lo > hi {
+			return nil, nil
+		}
+
+		value := postOrder[next]
+		next--
+		mid, ok := lookupIndex(index, value)
+		if !ok || mid < lo || mid > hi {
+			return nil, fmt.Errorf("%w: value %v does not fit the in-order traversal", ErrInvalidTraversal, value)
+		}
+
+		node := NewNode(value)
+		if node.Right, err = build(mid+1, hi); err != nil {
+			return nil, err
+		}
+		if node.Left, err = build(lo, mid-1); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+
+	root, err := build(0, len(inOrder)-1)
+	if err != nil {
+		return nil, err
+	}
+	return &Tree[T]{root: root, size: len(inOrder)}, nil
+}
+
+// NewBalancedBSTFromSorted builds a height-balanced binary search tree from
+// values sorted in strictly ascending order. The tree is ready for the BST methods.
+// Returns an error wrapping ErrInvalidTraversal if the values are not
+// strictly ascending.
+func NewBalancedBSTFromSorted[T cmp.Ordered](values []T) (*Tree[T], error) {
+	for i := 1; i < len(values); i++ {
+		if values[i-1] >= values[i] {
+			return nil, fmt.Errorf("%w: values are not strictly ascending at index %d", ErrInvalidTraversal, i)
+		}
+	}
+
+	tree := NewBST[T]()
+	tree.root = balancedHelper(values)
+	tree.size = len(values)
+	return tree, nil
+}
+
+func balancedHelper[T any](values []T) *Node[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	node := NewNode(values[mid])
+	node.Left = balancedHelper(values[:mid])
+	node.Right = balancedHelper(values[mid+1:])
+	return node
+}
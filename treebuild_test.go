@@ -0,0 +1,224 @@
+package collections
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestNewTreeFromLevelOrder(t *testing.T) {
+	// LeetCode-style input with -1 as the missing marker
+	//     1
+	//      \
+	//       2
+	//      /
+	//     3
+	tree, err := NewTreeFromLevelOrder([]int{1, -1, 2, 3}, -1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tree.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", tree.Size())
+	}
+	if tree.root.Left != nil || tree.root.Right.Left.Value != 3 {
+		t.Error("Tree does not have the expected shape")
+	}
+	if !slices.Equal(tree.InOrder(), []int{1, 3, 2}) {
+		t.Errorf("Expected in-order [1 3 2], got %v", tree.InOrder())
+	}
+
+	// Complete input matches Insert
+	tree, _ = NewTreeFromLevelOrder([]int{1, 2, 3, 4, 5}, -1)
+	inserted := NewTree[int]()
+	for i := 1; i <= 5; i++ {
+		inserted.Insert(i)
+	}
+	if !slices.Equal(tree.PreOrder(), inserted.PreOrder()) {
+		t.Errorf("Expected pre-order %v, got %v", inserted.PreOrder(), tree.PreOrder())
+	}
+
+	// Empty inputs
+	for _, values := range [][]int{nil, {-1}, {-1, -1}} {
+		tree, err := NewTreeFromLevelOrder(values, -1)
+		if err != nil || !tree.IsEmpty() {
+			t.Errorf("Expected empty tree for %v, got %v and error %v", values, tree, err)
+		}
+	}
+}
+
+func TestNewTreeFromLevelOrderErrors(t *testing.T) {
+	tests := [][]int{
+		{-1, 1},
+		{1, -1, -1, 2},
+	}
+
+	for _, values := range tests {
+		if _, err := NewTreeFromLevelOrder(values, -1); !errors.Is(err, ErrInvalidTraversal) {
+			t.Errorf("Expected ErrInvalidTraversal for %v, got %v", values, err)
+		}
+	}
+}
+
+func TestFromPreAndInOrder(t *testing.T) {
+	original, _ := NewTreeFromLevelOrder([]int{3, 9, 20, -1, -1, 15, 7}, -1)
+
+	tree, err := FromPreAndInOrder(original.PreOrder(), original.InOrder())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tree.Size() != original.Size() {
+		t.Errorf("Expected size %d, got %d", original.Size(), tree.Size())
+	}
+	if !slices.Equal(tree.LevelOrder(), original.LevelOrder()) {
+		t.Errorf("Expected level-order %v, got %v", original.LevelOrder(), tree.LevelOrder())
+	}
+	if !slices.Equal(tree.PostOrder(), original.PostOrder()) {
+		t.Errorf("Expected post-order %v, got %v", original.PostOrder(), tree.PostOrder())
+	}
+
+	empty, err := FromPreAndInOrder([]int{}, []int{})
+	if err != nil || !empty.IsEmpty() {
+		t.Error("Empty traversals should build an empty tree")
+	}
+}
+
+func TestFromPostAndInOrder(t *testing.T) {
+	original, _ := NewTreeFromLevelOrder([]int{1, 2, 3, -1, 4, 5, -1, 6}, -1)
+
+	tree, err := FromPostAndInOrder(original.PostOrder(), original.InOrder())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(tree.LevelOrder(), original.LevelOrder()) {
+		t.Errorf("Expected level-order %v, got %v", original.LevelOrder(), tree.LevelOrder())
+	}
+	if !slices.Equal(tree.PreOrder(), original.PreOrder()) {
+		t.Errorf("Expected pre-order %v, got %v", original.PreOrder(), tree.PreOrder())
+	}
+}
+
+func TestFromTraversalsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    []int
+		inOrder  []int
+		fromPost bool
+	}{
+		{"length mismatch", []int{1, 2}, []int{1}, false},
+		{"duplicate values", []int{1, 1}, []int{1, 1}, false},
+		{"unknown value", []int{1, 3}, []int{2, 1}, false},
+		{"inconsistent order", []int{1, 2, 3}, []int{3, 1, 2}, false},
+		{"post length mismatch", []int{1}, []int{1, 2}, true},
+		{"post inconsistent order", []int{2, 1, 3}, []int{1, 3, 2}, true},
+	}
+
+	for _, tt := range tests {
+		var err error
+		if tt.fromPost {
+			_, err = FromPostAndInOrder(tt.order, tt.inOrder)
+		} else {
+			_, err = FromPreAndInOrder(tt.order, tt.inOrder)
+		}
+		if !errors.Is(err, ErrInvalidTraversal) {
+			t.Errorf("%s: expected ErrInvalidTraversal, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestFromTraversalsNotComparable(t *testing.T) {
+	// Values that cannot be map keys give an error instead of a panic
+	sliceValues := [][]int{{1}, {2}}
+	if _, err := FromPreAndInOrder(sliceValues, sliceValues); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for slices, got %v", err)
+	}
+	if _, err := FromPostAndInOrder(sliceValues, sliceValues); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for slices, got %v", err)
+	}
+
+	// Only the pre-order side holds a slice
+	if _, err := FromPreAndInOrder([]any{[]int{1}}, []any{1}); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for a slice in pre-order, got %v", err)
+	}
+	if _, err := FromPostAndInOrder([]any{1, map[string]int{}}, []any{1, 2}); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for a map in post-order, got %v", err)
+	}
+
+	// Comparable values of an interface type still work
+	tree, err := FromPreAndInOrder([]any{2, "a", nil}, []any{"a", 2, nil})
+	if err != nil || tree.Size() != 3 {
+		t.Errorf("Unexpected result %v, %v", tree, err)
+	}
+
+	if _, err := NewTreeFromLevelOrder(sliceValues, nil); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for a slice nil marker, got %v", err)
+	}
+	if tree, err := NewTreeFromLevelOrder([]any{[]int{1}, nil, []int{2}}, nil); err != nil || tree.Size() != 2 {
+		t.Errorf("Unexpected result %v, %v", tree, err)
+	}
+}
+
+func TestTraversalRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(17, 17))
+
+	for round := range 20 {
+		// Random shape with unique values
+		values := rng.Perm(30)
+		original := NewBST[int]()
+		for _, v := range values[:rng.IntN(30)+1] {
+			original.InsertBST(v)
+		}
+
+		fromPre, err := FromPreAndInOrder(original.PreOrder(), original.InOrder())
+		if err != nil {
+			t.Fatalf("Round %d: unexpected error: %v", round, err)
+		}
+		fromPost, err := FromPostAndInOrder(original.PostOrder(), original.InOrder())
+		if err != nil {
+			t.Fatalf("Round %d: unexpected error: %v", round, err)
+		}
+
+		for _, tree := range []*Tree[int]{fromPre, fromPost} {
+			if !slices.Equal(tree.LevelOrder(), original.LevelOrder()) {
+				t.Fatalf("Round %d: expected level-order %v, got %v", round, original.LevelOrder(), tree.LevelOrder())
+			}
+			if tree.MaxDepth() != original.MaxDepth() {
+				t.Fatalf("Round %d: expected depth %d, got %d", round, original.MaxDepth(), tree.MaxDepth())
+			}
+		}
+	}
+}
+
+func TestNewBalancedBSTFromSorted(t *testing.T) {
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = i * 2
+	}
+
+	tree, err := NewBalancedBSTFromSorted(values)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tree.Size() != 100 {
+		t.Errorf("Expected size 100, got %d", tree.Size())
+	}
+	if tree.MaxDepth() != 7 {
+		t.Errorf("Expected max depth 7, got %d", tree.MaxDepth())
+	}
+	if !tree.IsValidBST() {
+		t.Error("Tree should be a valid BST")
+	}
+	if !slices.Equal(tree.InOrder(), values) {
+		t.Error("In-order traversal should match the input")
+	}
+	if _, found := tree.SearchBST(42); !found {
+		t.Error("SearchBST should find 42")
+	}
+
+	if _, err := NewBalancedBSTFromSorted([]int{1, 3, 2}); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for unsorted input, got %v", err)
+	}
+	if _, err := NewBalancedBSTFromSorted([]int{1, 1}); !errors.Is(err, ErrInvalidTraversal) {
+		t.Errorf("Expected ErrInvalidTraversal for duplicate input, got %v", err)
+	}
+}
@@ -0,0 +1,509 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// rbNode represents a node in a left-leaning red-black tree.
+type rbNode[K, V any] struct {
+	key   K
+	value V
+	left  *rbNode[K, V]
+	right *rbNode[K, V]
+	red   bool
+}
+
+// TreeMap represents an ordered map backed by a left-leaning red-black tree.
+// Insertions, deletions and lookups take O(log n) time and keys are
+// iterated in ascending order.
+type TreeMap[K, V any] struct {
+	root    *rbNode[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+// NewTreeMap creates and returns a new empty tree map ordered by the natural order of K.
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreeMapFunc creates and returns a new empty tree map ordered by compare.
+// compare must return a negative number when a < b, zero when a == b and a
+// positive number when a > b.
+func NewTreeMapFunc[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare}
+}
+
+func isRed[K, V any](node *rbNode[K, V]) bool {
+	return node != nil && node.red
+}
+
+func rbRotateLeft[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	pivot.red = node.red
+	node.red = true
+	return pivot
+}
+
+func rbRotateRight[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	pivot.red = node.red
+	node.red = true
+	return pivot
+}
+
+func rbFlipColors[K, V any](node *rbNode[K, V]) {
+	node.red = !node.red
+	node.left.red = !node.left.red
+	node.right.red = !node.right.red
+}
+
+// rbFixUp restores the left-leaning red-black invariants on the way up.
+func rbFixUp[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	if isRed(node.right) && !isRed(node.left) {
+		node = rbRotateLeft(node)
+	}
+	if isRed(node.left) && isRed(node.left.left) {
+		node = rbRotateRight(node)
+	}
+	if isRed(node.left) && isRed(node.right) {
+		rbFlipColors(node)
+	}
+	return node
+}
+
+func rbMoveRedLeft[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	rbFlipColors(node)
+	if isRed(node.right.left) {
+		node.right = rbRotateRight(node.right)
+		node = rbRotateLeft(node)
+		rbFlipColors(node)
+	}
+	return node
+}
+
+func rbMoveRedRight[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	rbFlipColors(node)
+	if isRed(node.left.left) {
+		node = rbRotateRight(node)
+		rbFlipColors(node)
+	}
+	return node
+}
+
+func rbDeleteMin[K, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	if node.left == nil {
+		return nil
+	}
+	if !isRed(node.left) && !isRed(node.left.left) {
+		node = rbMoveRedLeft(node)
+	}
+	node.left = rbDeleteMin(node.left)
+	return rbFixUp(node)
+}
+
+// Put associates the value with the key, replacing any previous value.
+// Returns true if a new key was added.
+func (m *TreeMap[K, V]) Put(key K, value V) bool {
+	added := false
+	m.root = m.putHelper(m.root, key, value, &added)
+	m.root.red = false
+	if added {
+		m.size++
+	}
+	return added
+}
+
+func (m *TreeMap[K, V]) putHelper(node *rbNode[K, V], key K, value V, added *bool) *rbNode[K, V] {
+	if node == nil {
+		*added = true
+		return &rbNode[K, V]{key: key, value: value, red: true}
+	}
+
+	c := m.compare(key, node.key)
+	switch {
+	case c < 0:
+		node.left = m.putHelper(node.left, key, value, added)
+	case c > 0:
+		node.right = m.putHelper(node.right, key, value, added)
+	default:
+		node.value = value
+	}
+	return rbFixUp(node)
+}
+
+// find returns the node holding the key, or nil if there is none.
+func (m *TreeMap[K, V]) find(key K) *rbNode[K, V] {
+	current := m.root
+	for current != nil {
+		c := m.compare(key, current.key)
+		switch {
+		case c == 0:
+			return current
+		case c < 0:
+			current = current.left
+		default:
+			current = current.right
+		}
+	}
+	return nil
+}
+
+// Get returns the value associated with the key.
+// Returns false if the key is not in the map.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	var zero V
+
+	node := m.find(key)
+	if node == nil {
+		return zero, false
+	}
+	return node.value, true
+}
+
+// ContainsKey checks if the key is in the map.
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+	return m.find(key) != nil
+}
+
+// Delete removes the key and its value from the map.
+// Returns false if the key is not in the map.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	if !m.ContainsKey(key) {
+		return false
+	}
+
+	if !isRed(m.root.left) && !isRed(m.root.right) {
+		m.root.red = true
+	}
+	m.root = m.deleteHelper(m.root, key)
+	if m.root != nil {
+		m.root.red = false
+	}
+	m.size--
+	return true
+}
+
+// deleteHelper removes the key from the subtree. The key must be present.
+func (m *TreeMap[K, V]) deleteHelper(node *rbNode[K, V], key K) *rbNode[K, V] {
+	if m.compare(key, node.key) < 0 {
+		if !isRed(node.left) && !isRed(node.left.left) {
+			node = rbMoveRedLeft(node)
+		}
+		node.left = m.deleteHelper(node.left, key)
+		return rbFixUp(node)
+	}
+
+	if isRed(node.left) {
+		node = rbRotateRight(node)
+	}
+	if m.compare(key, node.key) == 0 && node.right == nil {
+		return nil
+	}
+	if !isRed(node.right) && !isRed(node.right.left) {
+		node = rbMoveRedRight(node)
+	}
+	if m.compare(key, node.key) == 0 {
+		// Replace with the in-order successor
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.key = successor.key
+		node.value = successor.value
+		node.right = rbDeleteMin(node.right)
+	} else {
+		node.right = m.deleteHelper(node.right, key)
+	}
+	return rbFixUp(node)
+}
+
+// FirstKey returns the smallest key in the map.
+// Returns false if the map is empty.
+func (m *TreeMap[K, V]) FirstKey() (K, bool) {
+	var zero K
+
+	if m.root == nil {
+		return zero, false
+	}
+
+	current := m.root
+	for current.left != nil {
+		current = current.left
+	}
+	return current.key, true
+}
+
+// LastKey returns the largest key in the map.
+// Returns false if the map is empty.
+func (m *TreeMap[K, V]) LastKey() (K, bool) {
+	var zero K
+
+	if m.root == nil {
+		return zero, false
+	}
+
+	current := m.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.key, true
+}
+
+// FloorKey returns the greatest key less than or equal to key.
+// Returns false if there is no such key.
+func (m *TreeMap[K, V]) FloorKey(key K) (K, bool) {
+	return m.closestKey(key, true, true)
+}
+
+// CeilingKey returns the smallest key greater than or equal to key.
+// Returns false if there is no such key.
+func (m *TreeMap[K, V]) CeilingKey(key K) (K, bool) {
+	return m.closestKey(key, false, true)
+}
+
+// closestKey walks down the tree looking for the nearest key below (or above)
+// the given key, optionally accepting an exact match.
+func (m *TreeMap[K, V]) closestKey(key K, below, inclusive bool) (K, bool) {
+	var result K
+	found := false
+	current := m.root
+	for current != nil {
+		c := m.compare(current.key, key)
+		if c == 0 && inclusive {
+			return current.key, true
+		}
+
+		if below {
+			if c < 0 {
+				result, found = current.key, true
+				current = current.right
+			} else {
+				current = current.left
+			}
+		} else {
+			if c > 0 {
+				result, found = current.key, true
+				current = current.left
+			} else {
+				current = current.right
+			}
+		}
+	}
+	return result, found
+}
+
+// ascend calls fn for each key in [lower, upper) in ascending order, where a
+// nil bound means unbounded. Returns false if fn stopped the iteration.
+func (m *TreeMap[K, V]) ascend(node *rbNode[K, V], lower, upper *K, fn func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	aboveLower := lower == nil || m.compare(node.key, *lower) >= 0
+	belowUpper := upper == nil || m.compare(node.key, *upper) < 0
+
+	if aboveLower && !m.ascend(node.left, lower, upper, fn) {
+		return false
+	}
+	if aboveLower && belowUpper && !fn(node.key, node.value) {
+		return false
+	}
+	if belowUpper {
+		return m.ascend(node.right, lower, upper, fn)
+	}
+	return true
+}
+
+// ForEach applies a function to each key and value in ascending key order.
+func (m *TreeMap[K, V]) ForEach(fn func(K, V)) {
+	m.ascend(m.root, nil, nil, func(key K, value V) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Keys returns all keys in ascending order.
+func (m *TreeMap[K, V]) Keys() []K {
+	result := make([]K, 0, m.size)
+	m.ForEach(func(key K, _ V) {
+		result = append(result, key)
+	})
+	return result
+}
+
+// Values returns all values in ascending key order.
+func (m *TreeMap[K, V]) Values() []V {
+	result := make([]V, 0, m.size)
+	m.ForEach(func(_ K, value V) {
+		result = append(result, value)
+	})
+	return result
+}
+
+// HeadMap returns a view of the keys strictly less than to.
+func (m *TreeMap[K, V]) HeadMap(to K) *TreeMapView[K, V] {
+	return &TreeMapView[K, V]{m: m, upper: &to}
+}
+
+// TailMap returns a view of the keys greater than or equal to from.
+func (m *TreeMap[K, V]) TailMap(from K) *TreeMapView[K, V] {
+	return &TreeMapView[K, V]{m: m, lower: &from}
+}
+
+// SubMap returns a view of the keys in [from, to).
+func (m *TreeMap[K, V]) SubMap(from, to K) *TreeMapView[K, V] {
+	return &TreeMapView[K, V]{m: m, lower: &from, upper: &to}
+}
+
+// Len returns the number of keys in the map.
+func (m *TreeMap[K, V]) Len() int {
+	return m.size
+}
+
+// IsEmpty returns true if the map has no keys.
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all keys from the map.
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+	m.size = 0
+}
+
+// String returns a string representation of the map.
+func (m *TreeMap[K, V]) String() string {
+	if m.size == 0 {
+		return "TreeMap{empty}"
+	}
+	return "TreeMap{" + formatEntries(m.ForEach) + "}"
+}
+
+// formatEntries renders the entries produced by forEach as "k: v, k: v".
+func formatEntries[K, V any](forEach func(func(K, V))) string {
+	result := ""
+	forEach(func(key K, value V) {
+		if result != "" {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v: %v", key, value)
+	})
+	return result
+}
+
+// TreeMapView is a read-only view of a key range of a TreeMap.
+// The view reflects later changes to the underlying map.
+type TreeMapView[K, V any] struct {
+	m     *TreeMap[K, V]
+	lower *K // inclusive, nil means unbounded
+	upper *K // exclusive, nil means unbounded
+}
+
+// inRange checks if the key is within the bounds of the view.
+func (v *TreeMapView[K, V]) inRange(key K) bool {
+	if v.lower != nil && v.m.compare(key, *v.lower) < 0 {
+		return false
+	}
+	if v.upper != nil && v.m.compare(key, *v.upper) >= 0 {
+		return false
+	}
+	return true
+}
+
+// Get returns the value associated with the key if it is within the view.
+// Returns false if the key is outside the view or not in the map.
+func (v *TreeMapView[K, V]) Get(key K) (V, bool) {
+	var zero V
+
+	if !v.inRange(key) {
+		return zero, false
+	}
+	return v.m.Get(key)
+}
+
+// ContainsKey checks if the key is within the view and in the map.
+func (v *TreeMapView[K, V]) ContainsKey(key K) bool {
+	return v.inRange(key) && v.m.ContainsKey(key)
+}
+
+// FirstKey returns the smallest key in the view.
+// Returns false if the view is empty.
+func (v *TreeMapView[K, V]) FirstKey() (K, bool) {
+	var key K
+	var ok bool
+	if v.lower == nil {
+		key, ok = v.m.FirstKey()
+	} else {
+		key, ok = v.m.CeilingKey(*v.lower)
+	}
+	if !ok || !v.inRange(key) {
+		var zero K
+		return zero, false
+	}
+	return key, true
+}
+
+// LastKey returns the largest key in the view.
+// Returns false if the view is empty.
+func (v *TreeMapView[K, V]) LastKey() (K, bool) {
+	var key K
+	var ok bool
+	if v.upper == nil {
+		key, ok = v.m.LastKey()
+	} else {
+		key, ok = v.m.closestKey(*v.upper, true, false)
+	}
+	if !ok || !v.inRange(key) {
+		var zero K
+		return zero, false
+	}
+	return key, true
+}
+
+// ForEach applies a function to each key and value in the view in ascending key order.
+func (v *TreeMapView[K, V]) ForEach(fn func(K, V)) {
+	v.m.ascend(v.m.root, v.lower, v.upper, func(key K, value V) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Keys returns the keys in the view in ascending order.
+func (v *TreeMapView[K, V]) Keys() []K {
+	result := []K{}
+	v.ForEach(func(key K, _ V) {
+		result = append(result, key)
+	})
+	return result
+}
+
+// Len returns the number of keys in the view.
+// This takes time proportional to the number of keys in the view.
+func (v *TreeMapView[K, V]) Len() int {
+	count := 0
+	v.ForEach(func(K, V) {
+		count++
+	})
+	return count
+}
+
+// IsEmpty returns true if the view has no keys.
+func (v *TreeMapView[K, V]) IsEmpty() bool {
+	_, ok := v.FirstKey()
+	return !ok
+}
+
+// String returns a string representation of the view.
+func (v *TreeMapView[K, V]) String() string {
+	entries := formatEntries(v.ForEach)
+	if entries == "" {
+		return "TreeMapView{empty}"
+	}
+	return "TreeMapView{" + entries + "}"
+}
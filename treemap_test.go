@@ -0,0 +1,254 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkRedBlack verifies the BST order and the left-leaning red-black
+// invariants: no red right links, no two reds in a row and the same number
+// of black links on every path from the root to a nil link.
+// Returns the black height of the subtree.
+func checkRedBlack[K, V any](t *testing.T, node *rbNode[K, V], compare func(a, b K) int) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+	if node.left != nil && compare(node.left.key, node.key) >= 0 {
+		t.Errorf("Left child %v should be less than %v", node.left.key, node.key)
+	}
+	if node.right != nil && compare(node.right.key, node.key) <= 0 {
+		t.Errorf("Right child %v should be greater than %v", node.right.key, node.key)
+	}
+	if isRed(node.right) {
+		t.Errorf("Node %v has a red right child", node.key)
+	}
+	if isRed(node) && isRed(node.left) {
+		t.Errorf("Node %v and its left child are both red", node.key)
+	}
+
+	left := checkRedBlack(t, node.left, compare)
+	right := checkRedBlack(t, node.right, compare)
+	if left != right {
+		t.Errorf("Node %v has black heights %d and %d", node.key, left, right)
+	}
+
+	if !isRed(node) {
+		left++
+	}
+	return left
+}
+
+func TestNewTreeMap(t *testing.T) {
+	m := NewTreeMap[string, int]()
+	if m == nil {
+		t.Fatal("NewTreeMap() returned nil")
+	}
+	if !m.IsEmpty() {
+		t.Error("New map should be empty")
+	}
+	if _, ok := m.FirstKey(); ok {
+		t.Error("FirstKey on empty map should fail")
+	}
+}
+
+func TestTreeMapPutGet(t *testing.T) {
+	m := NewTreeMap[string, int]()
+
+	if !m.Put("b", 2) {
+		t.Error("Put of a new key should return true")
+	}
+	m.Put("a", 1)
+	m.Put("c", 3)
+	if m.Put("b", 20) {
+		t.Error("Put of an existing key should return false")
+	}
+
+	if m.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", m.Len())
+	}
+	if val, ok := m.Get("b"); !ok || val != 20 {
+		t.Errorf("Expected 20, got %d", val)
+	}
+	if _, ok := m.Get("z"); ok {
+		t.Error("Get of a missing key should fail")
+	}
+	if !m.ContainsKey("c") {
+		t.Error("Map should contain 'c'")
+	}
+
+	if !slices.Equal(m.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Expected keys [a b c], got %v", m.Keys())
+	}
+	if !slices.Equal(m.Values(), []int{1, 20, 3}) {
+		t.Errorf("Expected values [1 20 3], got %v", m.Values())
+	}
+}
+
+func TestTreeMapDelete(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i)
+	}
+
+	if !m.Delete(3) {
+		t.Error("Delete(3) should succeed")
+	}
+	if m.Delete(3) {
+		t.Error("Delete of a missing key should return false")
+	}
+	if m.ContainsKey(3) {
+		t.Error("Map should not contain 3 after delete")
+	}
+	if m.Len() != 9 {
+		t.Errorf("Expected length 9, got %d", m.Len())
+	}
+	checkRedBlack(t, m.root, m.compare)
+
+	for i := range 10 {
+		m.Delete(i)
+	}
+	if !m.IsEmpty() {
+		t.Error("Map should be empty after deleting every key")
+	}
+}
+
+func TestTreeMapOrderedKeys(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	for _, key := range []int{40, 10, 30, 20} {
+		m.Put(key, "")
+	}
+
+	tests := []struct {
+		name   string
+		fn     func() (int, bool)
+		want   int
+		wantOk bool
+	}{
+		{"FirstKey", m.FirstKey, 10, true},
+		{"LastKey", m.LastKey, 40, true},
+		{"FloorKey exact", func() (int, bool) { return m.FloorKey(30) }, 30, true},
+		{"FloorKey between", func() (int, bool) { return m.FloorKey(35) }, 30, true},
+		{"FloorKey below min", func() (int, bool) { return m.FloorKey(5) }, 0, false},
+		{"CeilingKey between", func() (int, bool) { return m.CeilingKey(11) }, 20, true},
+		{"CeilingKey above max", func() (int, bool) { return m.CeilingKey(41) }, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.fn()
+		if ok != tt.wantOk || got != tt.want {
+			t.Errorf("%s = %d, %v; expected %d, %v", tt.name, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestTreeMapViews(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	for i := range 10 {
+		m.Put(i, i*i)
+	}
+
+	head := m.HeadMap(3)
+	if !slices.Equal(head.Keys(), []int{0, 1, 2}) {
+		t.Errorf("Expected head keys [0 1 2], got %v", head.Keys())
+	}
+	if head.ContainsKey(3) {
+		t.Error("HeadMap should exclude its upper bound")
+	}
+
+	tail := m.TailMap(7)
+	if !slices.Equal(tail.Keys(), []int{7, 8, 9}) {
+		t.Errorf("Expected tail keys [7 8 9], got %v", tail.Keys())
+	}
+
+	sub := m.SubMap(4, 6)
+	if sub.Len() != 2 {
+		t.Errorf("Expected 2 keys in sub map, got %d", sub.Len())
+	}
+	if val, ok := sub.Get(5); !ok || val != 25 {
+		t.Errorf("Expected 25, got %d", val)
+	}
+	if _, ok := sub.Get(6); ok {
+		t.Error("SubMap should exclude its upper bound")
+	}
+	if key, _ := sub.FirstKey(); key != 4 {
+		t.Errorf("Expected first key 4, got %d", key)
+	}
+	if key, _ := sub.LastKey(); key != 5 {
+		t.Errorf("Expected last key 5, got %d", key)
+	}
+
+	// Views reflect later changes
+	m.Delete(4)
+	if key, _ := sub.FirstKey(); key != 5 {
+		t.Errorf("Expected first key 5 after delete, got %d", key)
+	}
+
+	empty := m.SubMap(20, 30)
+	if !empty.IsEmpty() {
+		t.Error("SubMap outside the keys should be empty")
+	}
+	if _, ok := empty.LastKey(); ok {
+		t.Error("LastKey on empty view should fail")
+	}
+	if str := empty.String(); str != "TreeMapView{empty}" {
+		t.Errorf("Expected 'TreeMapView{empty}', got '%s'", str)
+	}
+}
+
+func TestTreeMapRandomOperations(t *testing.T) {
+	m := NewTreeMap[int, int]()
+	reference := map[int]int{}
+	rng := rand.New(rand.NewPCG(11, 11))
+
+	for i := range 3000 {
+		key := rng.IntN(300)
+		if rng.IntN(3) == 0 {
+			_, exists := reference[key]
+			if m.Delete(key) != exists {
+				t.Fatalf("Delete(%d) disagreed with reference", key)
+			}
+			delete(reference, key)
+		} else {
+			m.Put(key, i)
+			reference[key] = i
+		}
+
+		if i%250 == 0 {
+			checkRedBlack(t, m.root, m.compare)
+		}
+	}
+
+	checkRedBlack(t, m.root, m.compare)
+	if isRed(m.root) {
+		t.Error("Root should be black")
+	}
+	if m.Len() != len(reference) {
+		t.Fatalf("Expected length %d, got %d", len(reference), m.Len())
+	}
+	for key, val := range reference {
+		if got, ok := m.Get(key); !ok || got != val {
+			t.Errorf("Expected %d for key %d, got %d", val, key, got)
+		}
+	}
+}
+
+func TestTreeMapClearAndString(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	if str := m.String(); str != "TreeMap{empty}" {
+		t.Errorf("Expected 'TreeMap{empty}', got '%s'", str)
+	}
+
+	m.Put(2, "b")
+	m.Put(1, "a")
+	if str := m.String(); str != "TreeMap{1: a, 2: b}" {
+		t.Errorf("Expected 'TreeMap{1: a, 2: b}', got '%s'", str)
+	}
+
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Error("Map should be empty after Clear()")
+	}
+}
@@ -0,0 +1,290 @@
+package collections
+
+// parentsUntil runs a BFS from the root recording the parent of every node,
+// and stops once done returns true for the visited node.
+// Returns the parent map and whether done was satisfied.
+func (t *Tree[T]) parentsUntil(done func(*Node[T]) bool) (map[*Node[T]]*Node[T], bool) {
+	parents := map[*Node[T]]*Node[T]{}
+	if t.root == nil {
+		return parents, false
+	}
+
+	parents[t.root] = nil
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		if done(current) {
+			return parents, true
+		}
+		for _, child := range []*Node[T]{current.Left, current.Right} {
+			if child != nil {
+				parents[child] = current
+				queue.Enqueue(child)
+			}
+		}
+	}
+	return parents, false
+}
+
+// LowestCommonAncestor returns the deepest node that has both a and b as
+// descendants, where a node counts as a descendant of itself.
+// Values are matched by their first occurrence in BFS order.
+// Returns nil and false if either value is not in the tree.
+func (t *Tree[T]) LowestCommonAncestor(a, b T) (*Node[T], bool) {
+	var nodeA, nodeB *Node[T]
+	parents, found := t.parentsUntil(func(node *Node[T]) bool {
+		if nodeA == nil && any(node.Value) == any(a) {
+			nodeA = node
+		}
+		if nodeB == nil && any(node.Value) == any(b) {
+			nodeB = node
+		}
+		return nodeA != nil && nodeB != nil
+	})
+	if !found {
+		return nil, false
+	}
+
+	ancestors := map[*Node[T]]bool{}
+	for node := nodeA; node != nil; node = parents[node] {
+		ancestors[node] = true
+	}
+	for node := nodeB; node != nil; node = parents[node] {
+		if ancestors[node] {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+// PathTo returns the values on the path from the root to the first node
+// with the given value found using BFS, both ends included.
+// Returns nil if the value is not in the tree.
+func (t *Tree[T]) PathTo(value T) []T {
+	ancestors := t.Ancestors(value)
+	if ancestors == nil {
+		return nil
+	}
+
+	path := make([]T, 0, len(ancestors)+1)
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		path = append(path, ancestors[i])
+	}
+	return append(path, value)
+}
+
+// Ancestors returns the values of the ancestors of the first node with the
+// given value found using BFS, starting with its parent and ending with the root.
+// Returns an empty slice for the root and nil if the value is not in the tree.
+func (t *Tree[T]) Ancestors(value T) []T {
+	var target *Node[T]
+	parents, found := t.parentsUntil(func(node *Node[T]) bool {
+		if any(node.Value) == any(value) {
+			target = node
+			return true
+		}
+		return false
+	})
+	if !found {
+		return nil
+	}
+
+	result := []T{}
+	for node := parents[target]; node != nil; node = parents[node] {
+		result = append(result, node.Value)
+	}
+	return result
+}
+
+// LevelOf returns the depth of the first node with the given value found
+// using BFS. The root is at depth 0.
+// Returns -1 if the value is not in the tree.
+func (t *Tree[T]) LevelOf(value T) int {
+	if t.root == nil {
+		return -1
+	}
+
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+
+	for depth := 0; !queue.IsEmpty(); depth++ {
+		for range queue.Len() {
+			current, _ := queue.Next()
+			if any(current.Value) == any(value) {
+				return depth
+			}
+			if current.Left != nil {
+				queue.Enqueue(current.Left)
+			}
+			if current.Right != nil {
+				queue.Enqueue(current.Right)
+			}
+		}
+	}
+	return -1
+}
+
+// NodesAtDepth returns the values of all nodes at the given depth, from left
+// to right. The root is at depth 0.
+func (t *Tree[T]) NodesAtDepth(depth int) []T {
+	result := []T{}
+	if depth < 0 {
+		return result
+	}
+
+	t.walkLevels(func(level int, nodes []*Node[T]) bool {
+		if level < depth {
+			return true
+		}
+		for _, node := range nodes {
+			result = append(result, node.Value)
+		}
+		return false
+	})
+	return result
+}
+
+// Width returns the largest number of nodes found at any single depth.
+func (t *Tree[T]) Width() int {
+	width := 0
+	t.walkLevels(func(_ int, nodes []*Node[T]) bool {
+		width = max(width, len(nodes))
+		return true
+	})
+	return width
+}
+
+// walkLevels calls fn with the nodes of each level of the tree, starting at
+// depth 0. The walk stops early if fn returns false.
+func (t *Tree[T]) walkLevels(fn func(depth int, nodes []*Node[T]) bool) {
+	if t.root == nil {
+		return
+	}
+
+	level := []*Node[T]{t.root}
+	for depth := 0; len(level) > 0; depth++ {
+		if !fn(depth, level) {
+			return
+		}
+
+		next := []*Node[T]{}
+		for _, node := range level {
+			if node.Left != nil {
+				next = append(next, node.Left)
+			}
+			if node.Right != nil {
+				next = append(next, node.Right)
+			}
+		}
+		level = next
+	}
+}
+
+// subtreeHeights computes the height of every node in a single post-order pass.
+func (t *Tree[T]) subtreeHeights(visit func(node *Node[T], left, right int)) {
+	heights := map[*Node[T]]int{}
+	stack := []*Node[T]{}
+	var lastVisited *Node[T]
+	current := t.root
+
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.Left
+		}
+
+		top := stack[len(stack)-1]
+		if top.Right != nil && top.Right != lastVisited {
+			current = top.Right
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		left, right := heights[top.Left], heights[top.Right]
+		heights[top] = 1 + max(left, right)
+		visit(top, left, right)
+		lastVisited = top
+	}
+}
+
+// Diameter returns the number of edges on the longest path between any two nodes.
+func (t *Tree[T]) Diameter() int {
+	diameter := 0
+	t.subtreeHeights(func(_ *Node[T], left, right int) {
+		diameter = max(diameter, left+right)
+	})
+	return diameter
+}
+
+// IsBalanced returns true if the heights of the two subtrees of every node
+// differ by at most one.
+func (t *Tree[T]) IsBalanced() bool {
+	balanced := true
+	t.subtreeHeights(func(_ *Node[T], left, right int) {
+		if left-right > 1 || right-left > 1 {
+			balanced = false
+		}
+	})
+	return balanced
+}
+
+// IsComplete returns true if every level except possibly the last is full
+// and the nodes of the last level are as far left as possible.
+// Trees built only with Insert are always complete.
+func (t *Tree[T]) IsComplete() bool {
+	if t.root == nil {
+		return true
+	}
+
+	queue := NewQueue[*Node[T]]()
+	queue.Enqueue(t.root)
+	seenGap := false
+
+	for !queue.IsEmpty() {
+		current, _ := queue.Next()
+		for _, child := range []*Node[T]{current.Left, current.Right} {
+			if child == nil {
+				seenGap = true
+				continue
+			}
+			if seenGap {
+				return false
+			}
+			queue.Enqueue(child)
+		}
+	}
+	return true
+}
+
+// IsFull returns true if every node has either zero or two children.
+func (t *Tree[T]) IsFull() bool {
+	full := true
+	walkNodes(t.root, func(node *Node[T]) {
+		if (node.Left == nil) != (node.Right == nil) {
+			full = false
+		}
+	})
+	return full
+}
+
+// IsPerfect returns true if every internal node has two children and all
+// leaves are at the same depth.
+func (t *Tree[T]) IsPerfect() bool {
+	// Each level must hold exactly twice as many nodes as the one above it
+	perfect := true
+	t.walkLevels(func(depth int, nodes []*Node[T]) bool {
+		if len(nodes) != 1<<depth {
+			perfect = false
+		}
+		return perfect
+	})
+	return perfect
+}
+
+// IsSymmetric returns true if the tree is a mirror image of itself,
+// comparing both shape and values.
+func (t *Tree[T]) IsSymmetric() bool {
+	return t.root == nil || equalNodes(t.root.Left, t.root.Right, func(a, b T) bool { return any(a) == any(b) }, true)
+}
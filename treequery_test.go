@@ -0,0 +1,170 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+// newSampleTree builds the tree used by the structural query tests.
+//
+//	    1
+//	   / \
+//	  2   3
+//	 / \   \
+//	4   5   6
+//	     \
+//	      7
+func newSampleTree() *Tree[int] {
+	tree, _ := NewTreeFromLevelOrder([]int{1, 2, 3, 4, 5, -1, 6, -1, -1, -1, 7}, -1)
+	return tree
+}
+
+func TestLowestCommonAncestor(t *testing.T) {
+	tree := newSampleTree()
+
+	tests := []struct {
+		a, b     int
+		expected int
+	}{
+		{4, 7, 2},
+		{7, 6, 1},
+		{2, 5, 2},
+		{3, 3, 3},
+	}
+
+	for _, tt := range tests {
+		node, found := tree.LowestCommonAncestor(tt.a, tt.b)
+		if !found || node.Value != tt.expected {
+			t.Errorf("LowestCommonAncestor(%d, %d): expected %d, got %v", tt.a, tt.b, tt.expected, node)
+		}
+	}
+
+	if _, found := tree.LowestCommonAncestor(4, 42); found {
+		t.Error("LowestCommonAncestor with a missing value should fail")
+	}
+}
+
+func TestPathToAndAncestors(t *testing.T) {
+	tree := newSampleTree()
+
+	if path := tree.PathTo(7); !slices.Equal(path, []int{1, 2, 5, 7}) {
+		t.Errorf("Expected path [1 2 5 7], got %v", path)
+	}
+	if path := tree.PathTo(1); !slices.Equal(path, []int{1}) {
+		t.Errorf("Expected path [1], got %v", path)
+	}
+	if path := tree.PathTo(42); path != nil {
+		t.Errorf("Expected nil path for missing value, got %v", path)
+	}
+
+	if ancestors := tree.Ancestors(7); !slices.Equal(ancestors, []int{5, 2, 1}) {
+		t.Errorf("Expected ancestors [5 2 1], got %v", ancestors)
+	}
+	if ancestors := tree.Ancestors(1); ancestors == nil || len(ancestors) != 0 {
+		t.Errorf("Expected no ancestors for the root, got %v", ancestors)
+	}
+	if ancestors := tree.Ancestors(42); ancestors != nil {
+		t.Errorf("Expected nil ancestors for missing value, got %v", ancestors)
+	}
+}
+
+func TestLevelOfAndNodesAtDepth(t *testing.T) {
+	tree := newSampleTree()
+
+	if level := tree.LevelOf(1); level != 0 {
+		t.Errorf("Expected level 0, got %d", level)
+	}
+	if level := tree.LevelOf(7); level != 3 {
+		t.Errorf("Expected level 3, got %d", level)
+	}
+	if level := tree.LevelOf(42); level != -1 {
+		t.Errorf("Expected level -1, got %d", level)
+	}
+
+	if nodes := tree.NodesAtDepth(2); !slices.Equal(nodes, []int{4, 5, 6}) {
+		t.Errorf("Expected [4 5 6] at depth 2, got %v", nodes)
+	}
+	if nodes := tree.NodesAtDepth(9); len(nodes) != 0 {
+		t.Errorf("Expected no nodes at depth 9, got %v", nodes)
+	}
+	if width := tree.Width(); width != 3 {
+		t.Errorf("Expected width 3, got %d", width)
+	}
+}
+
+func TestDiameterAndIsBalanced(t *testing.T) {
+	tree := newSampleTree()
+
+	// Longest path: 7 -> 5 -> 2 -> 1 -> 3 -> 6
+	if diameter := tree.Diameter(); diameter != 5 {
+		t.Errorf("Expected diameter 5, got %d", diameter)
+	}
+	if !tree.IsBalanced() {
+		t.Error("Sample tree should be balanced")
+	}
+
+	chain, _ := NewTreeFromLevelOrder([]int{1, 2, -1, 3}, -1)
+	if chain.IsBalanced() {
+		t.Error("Chain of three nodes should not be balanced")
+	}
+	if diameter := chain.Diameter(); diameter != 2 {
+		t.Errorf("Expected diameter 2, got %d", diameter)
+	}
+
+	empty := NewTree[int]()
+	if empty.Diameter() != 0 || !empty.IsBalanced() {
+		t.Error("Empty tree should have diameter 0 and be balanced")
+	}
+}
+
+func TestTreeShapePredicates(t *testing.T) {
+	perfect, _ := NewTreeFromLevelOrder([]int{1, 2, 3, 4, 5, 6, 7}, -1)
+	complete, _ := NewTreeFromLevelOrder([]int{1, 2, 3, 4}, -1)
+	full, _ := NewTreeFromLevelOrder([]int{1, 2, 3, -1, -1, 4, 5}, -1)
+	sample := newSampleTree()
+
+	tests := []struct {
+		name                       string
+		tree                       *Tree[int]
+		isComplete, isFull, isPerf bool
+	}{
+		{"perfect", perfect, true, true, true},
+		{"complete", complete, true, false, false},
+		{"full", full, false, true, false},
+		{"sample", sample, false, false, false},
+		{"empty", NewTree[int](), true, true, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tree.IsComplete(); got != tt.isComplete {
+			t.Errorf("%s: IsComplete() = %v, expected %v", tt.name, got, tt.isComplete)
+		}
+		if got := tt.tree.IsFull(); got != tt.isFull {
+			t.Errorf("%s: IsFull() = %v, expected %v", tt.name, got, tt.isFull)
+		}
+		if got := tt.tree.IsPerfect(); got != tt.isPerf {
+			t.Errorf("%s: IsPerfect() = %v, expected %v", tt.name, got, tt.isPerf)
+		}
+	}
+}
+
+func TestIsSymmetric(t *testing.T) {
+	symmetric, _ := NewTreeFromLevelOrder([]int{1, 2, 2, 3, 4, 4, 3}, -1)
+	if !symmetric.IsSymmetric() {
+		t.Error("Tree should be symmetric")
+	}
+
+	values, _ := NewTreeFromLevelOrder([]int{1, 2, 2, 3, 4, 3, 4}, -1)
+	if values.IsSymmetric() {
+		t.Error("Tree with mismatched values should not be symmetric")
+	}
+
+	shape, _ := NewTreeFromLevelOrder([]int{1, 2, 2, -1, 3, -1, 3}, -1)
+	if shape.IsSymmetric() {
+		t.Error("Tree with mismatched shape should not be symmetric")
+	}
+
+	if !NewTree[int]().IsSymmetric() {
+		t.Error("Empty tree should be symmetric")
+	}
+}
@@ -0,0 +1,106 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// TreeSet represents an ordered set backed by a TreeMap.
+// Values are kept in ascending order without duplicates.
+type TreeSet[T any] struct {
+	m *TreeMap[T, struct{}]
+}
+
+// NewTreeSet creates and returns a new empty tree set ordered by the natural order of T.
+func NewTreeSet[T cmp.Ordered]() *TreeSet[T] {
+	return &TreeSet[T]{m: NewTreeMap[T, struct{}]()}
+}
+
+// NewTreeSetFunc creates and returns a new empty tree set ordered by compare.
+func NewTreeSetFunc[T any](compare func(a, b T) int) *TreeSet[T] {
+	return &TreeSet[T]{m: NewTreeMapFunc[T, struct{}](compare)}
+}
+
+// Add adds a value to the set.
+// Returns false if the value is already in the set.
+func (s *TreeSet[T]) Add(value T) bool {
+	return s.m.Put(value, struct{}{})
+}
+
+// Remove removes a value from the set.
+// Returns false if the value is not in the set.
+func (s *TreeSet[T]) Remove(value T) bool {
+	return s.m.Delete(value)
+}
+
+// Contains checks if the value is in the set.
+func (s *TreeSet[T]) Contains(value T) bool {
+	return s.m.ContainsKey(value)
+}
+
+// First returns the smallest value in the set.
+// Returns false if the set is empty.
+func (s *TreeSet[T]) First() (T, bool) {
+	return s.m.FirstKey()
+}
+
+// Last returns the largest value in the set.
+// Returns false if the set is empty.
+func (s *TreeSet[T]) Last() (T, bool) {
+	return s.m.LastKey()
+}
+
+// Floor returns the greatest value less than or equal to value.
+// Returns false if there is no such value.
+func (s *TreeSet[T]) Floor(value T) (T, bool) {
+	return s.m.FloorKey(value)
+}
+
+// Ceiling returns the smallest value greater than or equal to value.
+// Returns false if there is no such value.
+func (s *TreeSet[T]) Ceiling(value T) (T, bool) {
+	return s.m.CeilingKey(value)
+}
+
+// ForEach applies a function to each value in ascending order.
+func (s *TreeSet[T]) ForEach(fn func(T)) {
+	s.m.ForEach(func(value T, _ struct{}) {
+		fn(value)
+	})
+}
+
+// ToSlice returns all values in ascending order.
+func (s *TreeSet[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+// Len returns the number of values in the set.
+func (s *TreeSet[T]) Len() int {
+	return s.m.Len()
+}
+
+// IsEmpty returns true if the set has no values.
+func (s *TreeSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all values from the set.
+func (s *TreeSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// String returns a string representation of the set.
+func (s *TreeSet[T]) String() string {
+	if s.m.IsEmpty() {
+		return "TreeSet{empty}"
+	}
+
+	result := ""
+	s.ForEach(func(value T) {
+		if result != "" {
+			result += ", "
+		}
+		result += fmt.Sprintf("%v", value)
+	})
+	return "TreeSet{" + result + "}"
+}
@@ -0,0 +1,88 @@
+package collections
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewTreeSet(t *testing.T) {
+	s := NewTreeSet[int]()
+	if s == nil {
+		t.Fatal("NewTreeSet() returned nil")
+	}
+	if !s.IsEmpty() {
+		t.Error("New set should be empty")
+	}
+	if str := s.String(); str != "TreeSet{empty}" {
+		t.Errorf("Expected 'TreeSet{empty}', got '%s'", str)
+	}
+}
+
+func TestTreeSetOperations(t *testing.T) {
+	s := NewTreeSet[int]()
+	for _, val := range []int{5, 1, 3} {
+		if !s.Add(val) {
+			t.Errorf("Add(%d) should succeed", val)
+		}
+	}
+	if s.Add(3) {
+		t.Error("Add of a duplicate should return false")
+	}
+
+	if s.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", s.Len())
+	}
+	if !slices.Equal(s.ToSlice(), []int{1, 3, 5}) {
+		t.Errorf("Expected [1 3 5], got %v", s.ToSlice())
+	}
+	if str := s.String(); str != "TreeSet{1, 3, 5}" {
+		t.Errorf("Expected 'TreeSet{1, 3, 5}', got '%s'", str)
+	}
+
+	if first, _ := s.First(); first != 1 {
+		t.Errorf("Expected first 1, got %d", first)
+	}
+	if last, _ := s.Last(); last != 5 {
+		t.Errorf("Expected last 5, got %d", last)
+	}
+	if floor, _ := s.Floor(4); floor != 3 {
+		t.Errorf("Expected floor 3, got %d", floor)
+	}
+	if ceiling, _ := s.Ceiling(4); ceiling != 5 {
+		t.Errorf("Expected ceiling 5, got %d", ceiling)
+	}
+
+	if !s.Remove(3) {
+		t.Error("Remove(3) should succeed")
+	}
+	if s.Contains(3) {
+		t.Error("Set should not contain 3 after remove")
+	}
+
+	sum := 0
+	s.ForEach(func(val int) {
+		sum += val
+	})
+	if sum != 6 {
+		t.Errorf("Expected sum 6, got %d", sum)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("Set should be empty after Clear()")
+	}
+}
+
+func TestTreeSetWithComparator(t *testing.T) {
+	s := NewTreeSetFunc(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	s.Add("Go")
+	s.Add("go")
+	s.Add("Rust")
+
+	if s.Len() != 2 {
+		t.Errorf("Expected length 2, got %d", s.Len())
+	}
+}
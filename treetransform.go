@@ -0,0 +1,123 @@
+package collections
+
+// Clone creates a deep copy of the tree with the same shape and values.
+// The copy keeps the compare function used by the BST methods.
+func (t *Tree[T]) Clone() *Tree[T] {
+	clone := MapTree(t, func(value T) T { return value })
+	clone.compare = t.compare
+	return clone
+}
+
+// MapTree builds a new tree with the same shape as t where every value is
+// replaced by the result of fn.
+func MapTree[T, U any](t *Tree[T], fn func(T) U) *Tree[U] {
+	result := &Tree[U]{size: t.size}
+	if t.root == nil {
+		return result
+	}
+
+	type pair struct {
+		from *Node[T]
+		to   *Node[U]
+	}
+
+	result.root = NewNode(fn(t.root.Value))
+	stack := []pair{{t.root, result.root}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current.from.Left != nil {
+			current.to.Left = NewNode(fn(current.from.Left.Value))
+			stack = append(stack, pair{current.from.Left, current.to.Left})
+		}
+		if current.from.Right != nil {
+			current.to.Right = NewNode(fn(current.from.Right.Value))
+			stack = append(stack, pair{current.from.Right, current.to.Right})
+		}
+	}
+	return result
+}
+
+// Equal reports whether both trees have the same shape and equal values in
+// the same positions. If eq is nil, values are compared with ==.
+// Returns false if other is nil.
+func (t *Tree[T]) Equal(other *Tree[T], eq func(a, b T) bool) bool {
+	if other == nil {
+		return false
+	}
+	if eq == nil {
+		eq = func(a, b T) bool { return any(a) == any(b) }
+	}
+	return equalNodes(t.root, other.root, eq, false)
+}
+
+// IsMirrorOf reports whether the tree is the mirror image of other, comparing
+// both shape and values with ==. Returns false if other is nil.
+func (t *Tree[T]) IsMirrorOf(other *Tree[T]) bool {
+	return other != nil && equalNodes(t.root, other.root, func(a, b T) bool { return any(a) == any(b) }, true)
+}
+
+// IsSubtree reports whether other appears in the tree as the complete
+// subtree of some node, comparing shape and values with ==.
+// An empty tree is a subtree of every tree. Returns false if other is nil.
+func (t *Tree[T]) IsSubtree(other *Tree[T]) bool {
+	if other == nil {
+		return false
+	}
+	if other.root == nil {
+		return true
+	}
+
+	eq := func(a, b T) bool { return any(a) == any(b) }
+	found := false
+	walkNodes(t.root, func(node *Node[T]) {
+		if !found && eq(node.Value, other.root.Value) {
+			found = equalNodes(node, other.root, eq, false)
+		}
+	})
+	return found
+}
+
+// Mirror swaps the left and right children of every node in place.
+// A binary search tree keeps working: its order is reversed to match, so the BST methods see the values in descending order and Min
+// returns the largest value.
+func (t *Tree[T]) Mirror() {
+	walkNodes(t.root, func(node *Node[T]) {
+		node.Left, node.Right = node.Right, node.Left
+	})
+	if compare := t.order(); compare != nil {
+		t.compare = func(a, b T) int { return compare(b, a) }
+	}
+}
+
+// equalNodes compares two subtrees node by node using an explicit stack.
+// When mirrored is true, the left child of a is compared with the right child of b.
+func equalNodes[T any](a, b *Node[T], eq func(a, b T) bool, mirrored bool) bool {
+	pairs := [][2]*Node[T]{{a, b}}
+	for len(pairs) > 0 {
+		pair := pairs[len(pairs)-1]
+		pairs = pairs[:len(pairs)-1]
+
+		left, right := pair[0], pair[1]
+		if left == nil && right == nil {
+			continue
+		}
+		if left == nil || right == nil || !eq(left.Value, right.Value) {
+			return false
+		}
+
+		if mirrored {
+			pairs = append(pairs,
+				[2]*Node[T]{left.Left, right.Right},
+				[2]*Node[T]{left.Right, right.Left},
+			)
+		} else {
+			pairs = append(pairs,
+				[2]*Node[T]{left.Left, right.Left},
+				[2]*Node[T]{left.Right, right.Right},
+			)
+		}
+	}
+	return true
+}
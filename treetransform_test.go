@@ -0,0 +1,221 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestTreeClone(t *testing.T) {
+	tree := newSampleTree()
+	clone := tree.Clone()
+
+	if !clone.Equal(tree, nil) {
+		t.Fatal("Clone should be equal to the original")
+	}
+	if clone.Size() != tree.Size() {
+		t.Errorf("Expected size %d, got %d", tree.Size(), clone.Size())
+	}
+
+	// Changes to the clone must not affect the original
+	clone.root.Left.Value = 42
+	clone.Insert(8)
+	if tree.Contains(42) || tree.Size() != 7 {
+		t.Error("Modifying the clone changed the original")
+	}
+
+	empty := NewTree[int]().Clone()
+	if !empty.IsEmpty() {
+		t.Error("Clone of an empty tree should be empty")
+	}
+
+	// BST order is kept
+	bst := NewBST[int]()
+	for _, v := range []int{5, 3, 8} {
+		bst.InsertBST(v)
+	}
+	bstClone := bst.Clone()
+	bstClone.InsertBST(4)
+	if !slices.Equal(bstClone.InOrder(), []int{3, 4, 5, 8}) {
+		t.Errorf("Expected in-order [3 4 5 8], got %v", bstClone.InOrder())
+	}
+}
+
+func TestTreeEqual(t *testing.T) {
+	tree := newSampleTree()
+
+	if !tree.Equal(newSampleTree(), nil) {
+		t.Error("Trees with the same shape and values should be equal")
+	}
+	if !NewTree[int]().Equal(NewTree[int](), nil) {
+		t.Error("Empty trees should be equal")
+	}
+	if tree.Equal(NewTree[int](), nil) {
+		t.Error("A tree should not equal an empty tree")
+	}
+
+	// Same values in a different shape
+	other, _ := NewTreeFromLevelOrder([]int{1, 2, 3, 4, 5, 6, -1, -1, -1, -1, 7}, -1)
+	if tree.Equal(other, nil) {
+		t.Error("Trees with different shapes should not be equal")
+	}
+
+	// Same shape with a different value
+	other = newSampleTree()
+	other.root.Right.Right.Value = 16
+	if tree.Equal(other, nil) {
+		t.Error("Trees with different values should not be equal")
+	}
+	if !tree.Equal(other, func(a, b int) bool { return a%10 == b%10 }) {
+		t.Error("Custom eq should be used to compare values")
+	}
+}
+
+func TestTreeMirror(t *testing.T) {
+	tree := newSampleTree()
+	tree.Mirror()
+
+	if !slices.Equal(tree.LevelOrder(), []int{1, 3, 2, 6, 5, 4, 7}) {
+		t.Errorf("Expected level-order [1 3 2 6 5 4 7], got %v", tree.LevelOrder())
+	}
+	if !tree.IsMirrorOf(newSampleTree()) {
+		t.Error("Mirrored tree should be the mirror of the original")
+	}
+
+	tree.Mirror()
+	if !tree.Equal(newSampleTree(), nil) {
+		t.Error("Mirroring twice should restore the original")
+	}
+
+	empty := NewTree[int]()
+	empty.Mirror()
+	if !empty.IsEmpty() {
+		t.Error("Mirroring an empty tree should leave it empty")
+	}
+}
+
+func TestMirrorBST(t *testing.T) {
+	bst := NewBST[int]()
+	for _, v := range []int{5, 3, 8, 1, 4, 9} {
+		bst.InsertBST(v)
+	}
+	bst.Mirror()
+
+	if !bst.IsValidBST() {
+		t.Error("A mirrored BST should be valid under its reversed order")
+	}
+	for _, v := range []int{1, 3, 4, 5, 8, 9} {
+		if node, ok := bst.SearchBST(v); !ok || node.Value != v {
+			t.Errorf("SearchBST(%d) should find the value after Mirror", v)
+		}
+	}
+	if _, ok := bst.SearchBST(7); ok {
+		t.Error("SearchBST(7) should not find a missing value")
+	}
+	if !bst.InsertBST(7) || bst.InsertBST(4) || !bst.IsValidBST() {
+		t.Error("InsertBST should keep the mirrored order")
+	}
+	if !slices.Equal(bst.InOrder(), []int{9, 8, 7, 5, 4, 3, 1}) {
+		t.Errorf("Expected descending in-order, got %v", bst.InOrder())
+	}
+	if v, _ := bst.Min(); v != 9 {
+		t.Errorf("Expected Min 9 in the reversed order, got %d", v)
+	}
+
+	// A plain tree mirrors its natural order
+	plain := NewTree[int]()
+	for _, v := range []int{2, 1, 3} {
+		plain.Insert(v)
+	}
+	plain.Mirror()
+	if !plain.IsValidBST() || !plain.InsertBST(0) || !slices.Equal(plain.InOrder(), []int{3, 2, 1, 0}) {
+		t.Errorf("Expected a valid descending tree, got %v", plain.InOrder())
+	}
+
+	// Mirroring back restores the ascending order
+	bst.Mirror()
+	if !bst.IsValidBSTFunc(cmp.Compare[int]) || !bst.DeleteBST(5) || !bst.IsValidBST() {
+		t.Error("Mirroring twice should restore the original order")
+	}
+}
+
+func TestIsMirrorOf(t *testing.T) {
+	tree := newSampleTree()
+
+	if tree.IsMirrorOf(newSampleTree()) {
+		t.Error("An asymmetric tree should not be the mirror of itself")
+	}
+	if !NewTree[int]().IsMirrorOf(NewTree[int]()) {
+		t.Error("Empty trees should be mirrors of each other")
+	}
+
+	symmetric, _ := NewTreeFromLevelOrder([]int{1, 2, 2, 3, 4, 4, 3}, -1)
+	if !symmetric.IsMirrorOf(symmetric) {
+		t.Error("A symmetric tree should be the mirror of itself")
+	}
+}
+
+func TestTreeCompareNil(t *testing.T) {
+	tree := newSampleTree()
+	empty := NewTree[int]()
+	if tree.Equal(nil, nil) || empty.Equal(nil, nil) {
+		t.Error("A tree should not equal a nil tree")
+	}
+	if tree.IsMirrorOf(nil) || empty.IsMirrorOf(nil) {
+		t.Error("A tree should not be the mirror of a nil tree")
+	}
+	if tree.IsSubtree(nil) || empty.IsSubtree(nil) {
+		t.Error("A nil tree should not be a subtree")
+	}
+}
+
+func TestIsSubtree(t *testing.T) {
+	tree := newSampleTree()
+
+	tests := []struct {
+		values   []int
+		expected bool
+	}{
+		{[]int{2, 4, 5, -1, -1, -1, 7}, true},
+		{[]int{3, -1, 6}, true},
+		{[]int{7}, true},
+		{[]int{1, 2, 3, 4, 5, -1, 6, -1, -1, -1, 7}, true},
+		{[]int{2, 4, 5}, false},
+		{[]int{3, 6}, false},
+		{[]int{8}, false},
+		{nil, true},
+	}
+
+	for _, tt := range tests {
+		other, _ := NewTreeFromLevelOrder(tt.values, -1)
+		if got := tree.IsSubtree(other); got != tt.expected {
+			t.Errorf("IsSubtree(%v): expected %v, got %v", tt.values, tt.expected, got)
+		}
+	}
+
+	if NewTree[int]().IsSubtree(tree) {
+		t.Error("A non-empty tree should not be a subtree of an empty tree")
+	}
+}
+
+func TestMapTree(t *testing.T) {
+	tree := newSampleTree()
+	mapped := MapTree(tree, strconv.Itoa)
+
+	if mapped.Size() != tree.Size() {
+		t.Errorf("Expected size %d, got %d", tree.Size(), mapped.Size())
+	}
+	expected := []string{"1", "2", "3", "4", "5", "6", "7"}
+	if !slices.Equal(mapped.LevelOrder(), expected) {
+		t.Errorf("Expected level-order %v, got %v", expected, mapped.LevelOrder())
+	}
+	if !slices.Equal(mapped.InOrder(), []string{"4", "2", "5", "7", "1", "3", "6"}) {
+		t.Errorf("Shape was not preserved, got in-order %v", mapped.InOrder())
+	}
+
+	empty := MapTree(NewTree[int](), strconv.Itoa)
+	if !empty.IsEmpty() {
+		t.Error("Mapping an empty tree should give an empty tree")
+	}
+}
@@ -0,0 +1,338 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type trieNode[V any] struct {
+	label    byte
+	value    V
+	hasValue bool
+	children []*trieNode[V] // sorted by label
+}
+
+// child returns the child with the given label and its position, or nil and
+// the position where it would be inserted.
+func (n *trieNode[V]) child(label byte) (*trieNode[V], int) {
+	i, found := slices.BinarySearchFunc(n.children, label, func(c *trieNode[V], b byte) int {
+		return int(c.label) - int(b)
+	})
+	if found {
+		return n.children[i], i
+	}
+	return nil, i
+}
+
+// byteKey is a key given either as a string or as a []byte.
+type byteKey interface {
+	string | []byte
+}
+
+// Trie maps string keys to values and answers prefix queries. Keys are split
+// into bytes, one node per byte, so any string works as a key. Keys are
+// visited in lexicographic byte order. Lookups take O(len(key)) time, however
+// many keys there are.
+//
+// Keys are stored as strings. InsertBytes, GetBytes, ContainsBytes,
+// HasPrefixBytes and LongestPrefixOfBytes take a []byte instead, without
+// converting it first; they treat it as the string with the same bytes.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+// NewTrie creates and returns a new empty trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Insert associates the value with the key, replacing any previous value.
+// Returns true if a new key was added.
+func (t *Trie[V]) Insert(key string, value V) bool {
+	return trieInsert(t, key, value)
+}
+
+// InsertBytes is like Insert but takes the key as a []byte.
+func (t *Trie[V]) InsertBytes(key []byte, value V) bool {
+	return trieInsert(t, key, value)
+}
+
+func trieInsert[V any, K byteKey](t *Trie[V], key K, value V) bool {
+	node := &t.root
+	for i := 0; i < len(key); i++ {
+		next, pos := node.child(key[i])
+		if next == nil {
+			next = &trieNode[V]{label: key[i]}
+			node.children = slices.Insert(node.children, pos, next)
+		}
+		node = next
+	}
+
+	added := !node.hasValue
+	node.value = value
+	node.hasValue = true
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// trieFind returns the node reached by following key, or nil.
+func trieFind[V any, K byteKey](t *Trie[V], key K) *trieNode[V] {
+	node := &t.root
+	for i := 0; i < len(key) && node != nil; i++ {
+		node, _ = node.child(key[i])
+	}
+	return node
+}
+
+// trieGet returns the value for a key.
+func trieGet[V any, K byteKey](t *Trie[V], key K) (V, bool) {
+	if node := trieFind(t, key); node != nil && node.hasValue {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Get returns the value for a key.
+// Returns false if the key is not in the trie.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	return trieGet(t, key)
+}
+
+// GetBytes is like Get but takes the key as a []byte.
+func (t *Trie[V]) GetBytes(key []byte) (V, bool) {
+	return trieGet(t, key)
+}
+
+// Contains checks if the key is in the trie.
+func (t *Trie[V]) Contains(key string) bool {
+	_, ok := trieGet(t, key)
+	return ok
+}
+
+// ContainsBytes is like Contains but takes the key as a []byte.
+func (t *Trie[V]) ContainsBytes(key []byte) bool {
+	_, ok := trieGet(t, key)
+	return ok
+}
+
+// Delete removes a key and any nodes left without keys below them.
+// Returns false if the key is not in the trie.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	node := &t.root
+	path = append(path, node)
+	for i := 0; i < len(key); i++ {
+		if node, _ = node.child(key[i]); node == nil {
+			return false
+		}
+		path = append(path, node)
+	}
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.value = zero
+	node.hasValue = false
+	t.size--
+
+	// Prune the nodes that no longer lead to a key, from the bottom up
+	for i := len(path) - 1; i > 0; i-- {
+		current := path[i]
+		if current.hasValue || len(current.children) > 0 {
+			break
+		}
+		parent := path[i-1]
+		_, pos := parent.child(current.label)
+		parent.children = slices.Delete(parent.children, pos, pos+1)
+	}
+	return true
+}
+
+// HasPrefix returns true if any key in the trie starts with prefix.
+// Every key starts with the empty prefix, so HasPrefix("") is true unless
+// the trie is empty.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	return trieHasPrefix(t, prefix)
+}
+
+// HasPrefixBytes is like HasPrefix but takes the prefix as a []byte.
+func (t *Trie[V]) HasPrefixBytes(prefix []byte) bool {
+	return trieHasPrefix(t, prefix)
+}
+
+func trieHasPrefix[V any, K byteKey](t *Trie[V], prefix K) bool {
+	node := trieFind(t, prefix)
+	return node != nil && (node.hasValue || len(node.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys that start with prefix,
+// in lexicographic order. The trie must not be changed while iterating.
+func (t *Trie[V]) KeysWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for key := range t.walk(prefix) {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values of the trie in
+// lexicographic key order. The trie must not be changed while iterating.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.walk("")
+}
+
+// walk visits the keys below prefix in pre-order, which is lexicographic order.
+func (t *Trie[V]) walk(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		start := trieFind(t, prefix)
+		if start == nil {
+			return
+		}
+
+		type entry struct {
+			node *trieNode[V]
+			key  string
+		}
+		stack := []entry{{start, prefix}}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if current.node.hasValue && !yield(current.key, current.node.value) {
+				return
+			}
+			// Push the children in reverse so the smallest label comes out first
+			for i := len(current.node.children) - 1; i >= 0; i-- {
+				child := current.node.children[i]
+				stack = append(stack, entry{child, current.key + string([]byte{child.label})})
+			}
+		}
+	}
+}
+
+// LongestPrefixOf returns the longest key in the trie that is a prefix of s,
+// together with its value. This is the lookup used for routing, where the
+// most specific match wins. Returns false if no key is a prefix of s.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	return trieLongestPrefixOf(t, s)
+}
+
+// LongestPrefixOfBytes is like LongestPrefixOf but takes s as a []byte.
+// The returned key is a slice of s, not a copy.
+func (t *Trie[V]) LongestPrefixOfBytes(s []byte) ([]byte, V, bool) {
+	return trieLongestPrefixOf(t, s)
+}
+
+func trieLongestPrefixOf[V any, K byteKey](t *Trie[V], s K) (K, V, bool) {
+	var value V
+	length, found := 0, false
+
+	node := &t.root
+	for i := 0; ; i++ {
+		if node.hasValue {
+			value, length, found = node.value, i, true
+		}
+		if i == len(s) {
+			break
+		}
+		if node, _ = node.child(s[i]); node == nil {
+			break
+		}
+	}
+	return s[:length], value, found
+}
+
+// Keys returns all keys in lexicographic order.
+func (t *Trie[V]) Keys() []string {
+	keys := make([]string, 0, t.size)
+	for key := range t.walk("") {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Len returns the number of keys in the trie.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// IsEmpty returns true if the trie has no keys.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all keys from the trie.
+func (t *Trie[V]) Clear() {
+	t.root = trieNode[V]{}
+	t.size = 0
+}
+
+// String returns a drawing of the trie with one line per node, showing the
+// byte on the edge into the node and the value stored there:
+//
+//	Trie
+//	├── a
+//	│   └── t = 1
+//	└── o
+//	    └── n = 2
+func (t *Trie[V]) String() string {
+	if t.size == 0 {
+		return "Trie{empty}\n"
+	}
+
+	type entry struct {
+		node   *trieNode[V]
+		prefix string
+		last   bool
+	}
+
+	var b strings.Builder
+	b.WriteString("Trie" + formatTrieValue(t.root.hasValue, t.root.value) + "\n")
+
+	stack := []entry{}
+	pushChildren := func(node *trieNode[V], prefix string) {
+		for i := len(node.children) - 1; i >= 0; i-- {
+			stack = append(stack, entry{node.children[i], prefix, i == len(node.children)-1})
+		}
+	}
+	pushChildren(&t.root, "")
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		connector, indent := "├── ", "│   "
+		if current.last {
+			connector, indent = "└── ", "    "
+		}
+		label := formatTrieLabel(string([]byte{current.node.label}))
+		b.WriteString(current.prefix + connector + label + formatTrieValue(current.node.hasValue, current.node.value) + "\n")
+		pushChildren(current.node, current.prefix+indent)
+	}
+	return b.String()
+}
+
+// formatTrieLabel escapes the edge label of a Trie or RadixTree node so
+// control characters and invalid UTF-8 do not break the drawing.
+func formatTrieLabel(label string) string {
+	quoted := strconv.Quote(label)
+	return quoted[1 : len(quoted)-1]
+}
+
+// formatTrieValue returns " = value" for a node holding a key, or "".
+func formatTrieValue[V any](hasValue bool, value V) string {
+	if !hasValue {
+		return ""
+	}
+	return fmt.Sprintf(" = %v", value)
+}
@@ -0,0 +1,304 @@
+package collections
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewTrie(t *testing.T) {
+	trie := NewTrie[int]()
+	if trie == nil {
+		t.Fatal("NewTrie() returned nil")
+	}
+	if !trie.IsEmpty() || trie.Len() != 0 {
+		t.Error("New trie should be empty")
+	}
+	if str := trie.String(); str != "Trie{empty}\n" {
+		t.Errorf("Expected 'Trie{empty}', got %q", str)
+	}
+	if trie.HasPrefix("") {
+		t.Error("An empty trie has no keys with the empty prefix")
+	}
+}
+
+func TestTrieOperations(t *testing.T) {
+	trie := NewTrie[int]()
+	for i, key := range []string{"tea", "ten", "to", "inn", "in", "a"} {
+		if !trie.Insert(key, i) {
+			t.Errorf("Insert(%q) should add a new key", key)
+		}
+	}
+	if trie.Insert("to", 20) {
+		t.Error("Insert of an existing key should return false")
+	}
+	if trie.Len() != 6 {
+		t.Errorf("Expected length 6, got %d", trie.Len())
+	}
+
+	if v, ok := trie.Get("to"); !ok || v != 20 {
+		t.Errorf("Expected 20, got %d", v)
+	}
+	if _, ok := trie.Get("te"); ok {
+		t.Error("A prefix that is not a key should not be found")
+	}
+	if trie.Contains("tean") || !trie.Contains("in") {
+		t.Error("Contains is wrong")
+	}
+
+	if !slices.Equal(trie.Keys(), []string{"a", "in", "inn", "tea", "ten", "to"}) {
+		t.Errorf("Unexpected keys %v", trie.Keys())
+	}
+
+	// Deleting a key keeps the keys below it and prunes empty branches
+	if !trie.Delete("in") || trie.Delete("in") || trie.Delete("te") || trie.Delete("x") {
+		t.Error("Delete should succeed once and only for keys")
+	}
+	if !trie.Contains("inn") {
+		t.Error("Deleting a key should keep longer keys")
+	}
+	trie.Delete("inn")
+	if trie.HasPrefix("i") {
+		t.Error("The empty branch should have been pruned")
+	}
+	if len(trie.root.children) != 2 {
+		t.Errorf("Expected 2 branches at the root, got %d", len(trie.root.children))
+	}
+
+	trie.Clear()
+	if !trie.IsEmpty() || len(trie.Keys()) != 0 {
+		t.Error("Trie should be empty after Clear")
+	}
+}
+
+func TestTriePrefixes(t *testing.T) {
+	trie := NewTrie[string]()
+	trie.Insert("/", "root")
+	trie.Insert("/api", "api")
+	trie.Insert("/api/users", "users")
+	trie.Insert("/api/orders", "orders")
+	trie.Insert("/health", "health")
+
+	if !trie.HasPrefix("/ap") || !trie.HasPrefix("/api/users") || trie.HasPrefix("/apx") || !trie.HasPrefix("") {
+		t.Error("HasPrefix is wrong")
+	}
+
+	if got := slices.Collect(trie.KeysWithPrefix("/api")); !slices.Equal(got, []string{"/api", "/api/orders", "/api/users"}) {
+		t.Errorf("Unexpected keys %v", got)
+	}
+	if got := slices.Collect(trie.KeysWithPrefix("/x")); len(got) != 0 {
+		t.Errorf("Expected no keys, got %v", got)
+	}
+	for key := range trie.KeysWithPrefix("") {
+		if key != "/" {
+			t.Errorf("Expected / first, got %s", key)
+		}
+		break
+	}
+
+	tests := []struct {
+		s, key, value string
+		found         bool
+	}{
+		{"/api/users/42", "/api/users", "users", true},
+		{"/api/user", "/api", "api", true},
+		{"/api", "/api", "api", true},
+		{"/metrics", "/", "root", true},
+		{"metrics", "", "", false},
+	}
+	for _, tt := range tests {
+		key, value, found := trie.LongestPrefixOf(tt.s)
+		if key != tt.key || value != tt.value || found != tt.found {
+			t.Errorf("LongestPrefixOf(%q) = %q, %q, %v; expected %q, %q, %v", tt.s, key, value, found, tt.key, tt.value, tt.found)
+		}
+	}
+
+	// The empty key is a prefix of everything
+	trie.Insert("", "default")
+	if key, value, _ := trie.LongestPrefixOf("metrics"); key != "" || value != "default" {
+		t.Errorf("Expected the empty key, got %q", key)
+	}
+
+	all := maps.Collect(trie.All())
+	if len(all) != 6 || all["/api/orders"] != "orders" {
+		t.Errorf("Unexpected entries %v", all)
+	}
+}
+
+func TestTrieByteKeys(t *testing.T) {
+	trie := NewTrie[int]()
+	trie.Insert(string([]byte{0x00, 0xff}), 1)
+	trie.Insert(string([]byte{0x00}), 2)
+	trie.Insert(string([]byte{0xff, 0x00}), 3)
+
+	if v, ok := trie.Get(string([]byte{0x00, 0xff})); !ok || v != 1 {
+		t.Errorf("Expected 1, got %d", v)
+	}
+	if key, _, _ := trie.LongestPrefixOf(string([]byte{0x00, 0x01})); key != "\x00" {
+		t.Errorf("Expected \\x00, got %q", key)
+	}
+	if !slices.Equal(trie.Keys(), []string{"\x00", "\x00\xff", "\xff\x00"}) {
+		t.Errorf("Keys should be in byte order, got %q", trie.Keys())
+	}
+}
+
+func TestTrieBytesMethods(t *testing.T) {
+	for name, tree := range map[string]interface {
+		InsertBytes(key []byte, value int) bool
+		GetBytes(key []byte) (int, bool)
+		ContainsBytes(key []byte) bool
+		HasPrefixBytes(prefix []byte) bool
+		LongestPrefixOfBytes(s []byte) ([]byte, int, bool)
+		Get(key string) (int, bool)
+		Len() int
+	}{"Trie": NewTrie[int](), "RadixTree": NewRadixTree[int]()} {
+		key := []byte("/api/v1")
+		if !tree.InsertBytes(key, 1) || tree.InsertBytes([]byte("/api/v1"), 2) {
+			t.Errorf("%s: InsertBytes should add the key once", name)
+		}
+		tree.InsertBytes([]byte("/api"), 3)
+		tree.InsertBytes([]byte{0xff}, 4)
+
+		// The tree must not keep the caller's slice
+		key[1] = 'x'
+		if v, ok := tree.Get("/api/v1"); !ok || v != 2 {
+			t.Errorf("%s: Expected 2, got %d", name, v)
+		}
+		if v, ok := tree.GetBytes([]byte("/api")); !ok || v != 3 || tree.Len() != 3 {
+			t.Errorf("%s: Expected 3, got %d", name, v)
+		}
+		if _, ok := tree.GetBytes([]byte("/ap")); ok || tree.ContainsBytes([]byte("/ap")) || !tree.ContainsBytes([]byte{0xff}) {
+			t.Errorf("%s: Unexpected lookup result", name)
+		}
+		if !tree.HasPrefixBytes([]byte("/ap")) || !tree.HasPrefixBytes(nil) || tree.HasPrefixBytes([]byte("/b")) {
+			t.Errorf("%s: HasPrefixBytes is wrong", name)
+		}
+
+		s := []byte("/api/v2/users")
+		match, value, ok := tree.LongestPrefixOfBytes(s)
+		if !ok || string(match) != "/api" || value != 3 || &match[0] != &s[0] {
+			t.Errorf("%s: Expected a slice of the input holding /api, got %q", name, match)
+		}
+		if _, _, ok := tree.LongestPrefixOfBytes([]byte("api")); ok {
+			t.Errorf("%s: Expected no match", name)
+		}
+
+		if allocs := testing.AllocsPerRun(100, func() {
+			tree.GetBytes(s)
+			tree.HasPrefixBytes(s)
+			tree.LongestPrefixOfBytes(s)
+		}); allocs != 0 {
+			t.Errorf("%s: Lookups by []byte should not allocate, got %v", name, allocs)
+		}
+	}
+}
+
+func TestTrieString(t *testing.T) {
+	trie := NewTrie[int]()
+	trie.Insert("at", 1)
+	trie.Insert("on", 2)
+	trie.Insert("a", 3)
+
+	expected := strings.Join([]string{
+		"Trie",
+		"├── a = 3",
+		"│   └── t = 1",
+		"└── o",
+		"    └── n = 2",
+		"",
+	}, "\n")
+	if str := trie.String(); str != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, str)
+	}
+
+	trie.Insert("", 0)
+	trie.Insert("\n", 4)
+	if lines := strings.Split(trie.String(), "\n"); lines[0] != "Trie = 0" || lines[1] != `├── \n = 4` {
+		t.Errorf("Unexpected drawing:\n%s", trie.String())
+	}
+}
+
+func TestTrieRandomized(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	trie := NewTrie[int]()
+	radix := NewRadixTree[int]()
+	model := map[string]int{}
+
+	randomKey := func() string {
+		b := make([]byte, r.IntN(6))
+		for i := range b {
+			b[i] = "abc/"[r.IntN(4)]
+		}
+		return string(b)
+	}
+
+	for i := range 3000 {
+		key := randomKey()
+		if r.IntN(3) == 0 {
+			_, exists := model[key]
+			if trie.Delete(key) != exists || radix.Delete(key) != exists {
+				t.Fatalf("Step %d: Delete(%q) disagrees with the model", i, key)
+			}
+			delete(model, key)
+		} else {
+			_, exists := model[key]
+			if trie.Insert(key, i) == exists || radix.Insert(key, i) == exists {
+				t.Fatalf("Step %d: Insert(%q) disagrees with the model", i, key)
+			}
+			model[key] = i
+		}
+		checkRadix(t, radix)
+
+		prefix := randomKey()
+		var expected []string
+		for k := range model {
+			if strings.HasPrefix(k, prefix) {
+				expected = append(expected, k)
+			}
+		}
+		slices.Sort(expected)
+
+		for name, got := range map[string][]string{
+			"Trie":      slices.Collect(trie.KeysWithPrefix(prefix)),
+			"RadixTree": slices.Collect(radix.KeysWithPrefix(prefix)),
+		} {
+			if !slices.Equal(got, expected) {
+				t.Fatalf("Step %d: %s.KeysWithPrefix(%q) = %v, expected %v", i, name, prefix, got, expected)
+			}
+		}
+		if trie.HasPrefix(prefix) != (len(expected) > 0) || radix.HasPrefix(prefix) != (len(expected) > 0) {
+			t.Fatalf("Step %d: HasPrefix(%q) is wrong", i, prefix)
+		}
+		if trie.HasPrefixBytes([]byte(prefix)) != (len(expected) > 0) || radix.HasPrefixBytes([]byte(prefix)) != (len(expected) > 0) {
+			t.Fatalf("Step %d: HasPrefixBytes(%q) is wrong", i, prefix)
+		}
+		if _, exists := model[prefix]; trie.ContainsBytes([]byte(prefix)) != exists || radix.ContainsBytes([]byte(prefix)) != exists {
+			t.Fatalf("Step %d: ContainsBytes(%q) is wrong", i, prefix)
+		}
+
+		longest, found := "", false
+		for k := range model {
+			if strings.HasPrefix(prefix, k) && (!found || len(k) > len(longest)) {
+				longest, found = k, true
+			}
+		}
+		if key, value, ok := trie.LongestPrefixOf(prefix); key != longest || ok != found || (found && value != model[longest]) {
+			t.Fatalf("Step %d: Trie.LongestPrefixOf(%q) = %q, expected %q", i, prefix, key, longest)
+		}
+		if key, value, ok := radix.LongestPrefixOf(prefix); key != longest || ok != found || (found && value != model[longest]) {
+			t.Fatalf("Step %d: RadixTree.LongestPrefixOf(%q) = %q, expected %q", i, prefix, key, longest)
+		}
+		if key, _, ok := trie.LongestPrefixOfBytes([]byte(prefix)); string(key) != longest || ok != found {
+			t.Fatalf("Step %d: Trie.LongestPrefixOfBytes(%q) = %q, expected %q", i, prefix, key, longest)
+		}
+		if key, _, ok := radix.LongestPrefixOfBytes([]byte(prefix)); string(key) != longest || ok != found {
+			t.Fatalf("Step %d: RadixTree.LongestPrefixOfBytes(%q) = %q, expected %q", i, prefix, key, longest)
+		}
+
+		if trie.Len() != len(model) || radix.Len() != len(model) {
+			t.Fatalf("Step %d: expected length %d", i, len(model))
+		}
+	}
+}
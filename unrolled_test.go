@@ -0,0 +1,309 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// checkUnrolledList verifies that the node chain matches the list's bookkeeping
+// and that no node exceeds its capacity.
+func checkUnrolledList[T any](t *testing.T, l *UnrolledList[T]) {
+	t.Helper()
+
+	count := 0
+	var last *unrolledNode[T]
+	for current := l.head; current != nil; current = current.next {
+		if len(current.values) == 0 {
+			t.Error("Unrolled list should not keep empty nodes")
+		}
+		if len(current.values) > l.nodeCapacity {
+			t.Errorf("Node holds %d values, capacity is %d", len(current.values), l.nodeCapacity)
+		}
+		count += len(current.values)
+		last = current
+	}
+	if count != l.size {
+		t.Errorf("Expected %d values in nodes, got %d", l.size, count)
+	}
+	if last != l.tail {
+		t.Error("Tail should be the last node")
+	}
+}
+
+func TestNewUnrolledList(t *testing.T) {
+	list := NewUnrolledList[int]()
+	if list == nil {
+		t.Fatal("NewUnrolledList() returned nil")
+	}
+	if !list.IsEmpty() {
+		t.Error("New list should be empty")
+	}
+	if list.nodeCapacity != defaultUnrolledNodeCapacity {
+		t.Errorf("Expected node capacity %d, got %d", defaultUnrolledNodeCapacity, list.nodeCapacity)
+	}
+
+	if small := NewUnrolledListSize[int](0); small.nodeCapacity != 2 {
+		t.Errorf("Expected node capacity 2, got %d", small.nodeCapacity)
+	}
+}
+
+func TestUnrolledListAppendPrepend(t *testing.T) {
+	list := NewUnrolledListSize[int](4)
+	for i := 5; i < 10; i++ {
+		list.Append(i)
+	}
+	for i := 4; i >= 0; i-- {
+		list.Prepend(i)
+	}
+
+	if list.Len() != 10 {
+		t.Errorf("Expected length 10, got %d", list.Len())
+	}
+	checkUnrolledList(t, list)
+
+	slice := list.ToSlice()
+	for i := range 10 {
+		if slice[i] != i {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, i, slice[i])
+		}
+	}
+
+	if first, _ := list.GetFirst(); first != 0 {
+		t.Errorf("Expected first element 0, got %d", first)
+	}
+	if last, _ := list.GetLast(); last != 9 {
+		t.Errorf("Expected last element 9, got %d", last)
+	}
+}
+
+func TestUnrolledListInsertAt(t *testing.T) {
+	list := NewUnrolledListSize[int](4)
+	list.Append(1)
+	list.Append(2)
+	list.Append(4)
+	list.Append(5)
+
+	// Inserting into a full node splits it
+	if !list.InsertAt(2, 3) {
+		t.Error("InsertAt should succeed")
+	}
+	checkUnrolledList(t, list)
+
+	expected := []int{1, 2, 3, 4, 5}
+	slice := list.ToSlice()
+	for i, val := range expected {
+		if slice[i] != val {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+
+	if list.InsertAt(-1, 99) {
+		t.Error("InsertAt with negative index should fail")
+	}
+	if list.InsertAt(10, 99) {
+		t.Error("InsertAt with out of bounds index should fail")
+	}
+}
+
+func TestUnrolledListRemoveAt(t *testing.T) {
+	list := NewUnrolledListSize[int](4)
+	for i := range 12 {
+		list.Append(i)
+	}
+
+	if val, ok := list.RemoveAt(5); !ok || val != 5 {
+		t.Errorf("Expected to remove 5, got %d", val)
+	}
+	checkUnrolledList(t, list)
+
+	if val, ok := list.RemoveFirst(); !ok || val != 0 {
+		t.Errorf("Expected to remove 0, got %d", val)
+	}
+	if val, ok := list.RemoveLast(); !ok || val != 11 {
+		t.Errorf("Expected to remove 11, got %d", val)
+	}
+	checkUnrolledList(t, list)
+
+	if _, ok := list.RemoveAt(list.Len()); ok {
+		t.Error("RemoveAt with out of bounds index should fail")
+	}
+
+	for !list.IsEmpty() {
+		list.RemoveFirst()
+	}
+	checkUnrolledList(t, list)
+	if _, ok := list.RemoveLast(); ok {
+		t.Error("RemoveLast on empty list should fail")
+	}
+}
+
+func TestUnrolledListGetIndexOf(t *testing.T) {
+	list := NewUnrolledListSize[string](2)
+	list.Append("a")
+	list.Append("b")
+	list.Append("c")
+
+	if val, ok := list.Get(2); !ok || val != "c" {
+		t.Errorf("Expected 'c', got '%s'", val)
+	}
+	if _, ok := list.Get(3); ok {
+		t.Error("Get with out of bounds index should fail")
+	}
+	if index := list.IndexOf("b"); index != 1 {
+		t.Errorf("Expected index 1, got %d", index)
+	}
+	if index := list.IndexOf("z"); index != -1 {
+		t.Errorf("Expected index -1, got %d", index)
+	}
+	if !list.Contains("c") {
+		t.Error("List should contain 'c'")
+	}
+}
+
+func TestUnrolledListReverse(t *testing.T) {
+	list := NewUnrolledListSize[int](3)
+	for i := range 7 {
+		list.Append(i)
+	}
+
+	list.Reverse()
+	checkUnrolledList(t, list)
+
+	slice := list.ToSlice()
+	for i := range 7 {
+		if slice[i] != 6-i {
+			t.Errorf("Expected slice[%d] = %d, got %d", i, 6-i, slice[i])
+		}
+	}
+}
+
+func TestUnrolledListRandomOperations(t *testing.T) {
+	list := NewUnrolledListSize[int](5)
+	reference := []int{}
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	for i := range 2000 {
+		if len(reference) > 0 && rng.IntN(3) == 0 {
+			index := rng.IntN(len(reference))
+			val, _ := list.RemoveAt(index)
+			if val != reference[index] {
+				t.Fatalf("Expected to remove %d, got %d", reference[index], val)
+			}
+			reference = append(reference[:index], reference[index+1:]...)
+		} else {
+			index := rng.IntN(len(reference) + 1)
+			list.InsertAt(index, i)
+			reference = append(reference[:index], append([]int{i}, reference[index:]...)...)
+		}
+	}
+
+	checkUnrolledList(t, list)
+	slice := list.ToSlice()
+	if len(slice) != len(reference) {
+		t.Fatalf("Expected length %d, got %d", len(reference), len(slice))
+	}
+	for i, val := range reference {
+		if slice[i] != val {
+			t.Fatalf("Expected slice[%d] = %d, got %d", i, val, slice[i])
+		}
+	}
+}
+
+func TestUnrolledListClearAndString(t *testing.T) {
+	list := NewUnrolledListSize[int](2)
+	if str := list.String(); str != "UnrolledList{empty}" {
+		t.Errorf("Expected 'UnrolledList{empty}', got '%s'", str)
+	}
+
+	list.Append(1)
+	list.Append(2)
+	list.Append(3)
+	if str := list.String(); str != "UnrolledList{[1 2] -> [3]}" {
+		t.Errorf("Expected 'UnrolledList{[1 2] -> [3]}', got '%s'", str)
+	}
+
+	sum := 0
+	list.ForEach(func(val int) {
+		sum += val
+	})
+	if sum != 6 {
+		t.Errorf("Expected sum 6, got %d", sum)
+	}
+
+	list.Clear()
+	if !list.IsEmpty() {
+		t.Error("List should be empty after Clear()")
+	}
+}
+
+const benchmarkListSize = 10000
+
+func BenchmarkLinkedListAppend(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		list := NewLinkedList[int]()
+		for i := range benchmarkListSize {
+			list.Append(i)
+		}
+	}
+}
+
+func BenchmarkUnrolledListAppend(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		list := NewUnrolledList[int]()
+		for i := range benchmarkListSize {
+			list.Append(i)
+		}
+	}
+}
+
+func BenchmarkLinkedListGet(b *testing.B) {
+	list := NewLinkedList[int]()
+	for i := range benchmarkListSize {
+		list.Append(i)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		list.Get(benchmarkListSize / 2)
+	}
+}
+
+func BenchmarkUnrolledListGet(b *testing.B) {
+	list := NewUnrolledList[int]()
+	for i := range benchmarkListSize {
+		list.Append(i)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		list.Get(benchmarkListSize / 2)
+	}
+}
+
+func BenchmarkLinkedListForEach(b *testing.B) {
+	list := NewLinkedList[int]()
+	for i := range benchmarkListSize {
+		list.Append(i)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		sum := 0
+		list.ForEach(func(v int) { sum += v })
+	}
+}
+
+func BenchmarkUnrolledListForEach(b *testing.B) {
+	list := NewUnrolledList[int]()
+	for i := range benchmarkListSize {
+		list.Append(i)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		sum := 0
+		list.ForEach(func(v int) { sum += v })
+	}
+}